@@ -0,0 +1,55 @@
+// aggregate.go implements sums and products over slices of Uint1024
+package uint1024
+
+import "math/bits"
+
+// Sum adds all values: result = Σ vals mod 2^1024.
+// The boolean reports whether the exact sum exceeds 1024 bits. The sum of an
+// empty slice is zero. A single accumulator is updated in place, so only the
+// result is allocated.
+func Sum(vals []*Uint1024) (*Uint1024, bool) {
+	var acc Uint1024
+	overflow := false
+
+	for _, v := range vals {
+		var carry uint64
+		for i := range acc.words {
+			acc.words[i], carry = bits.Add64(acc.words[i], v.words[i], carry)
+		}
+		overflow = overflow || carry != 0
+	}
+
+	return &acc, overflow
+}
+
+// Product multiplies all values: result = Π vals mod 2^1024.
+// The boolean reports whether the exact product exceeds 1024 bits; a zero factor
+// makes the exact product zero, so the result is then zero without overflow.
+// The product of an empty slice is one. As in MulChecked, the full 2048-bit
+// product is only computed when the operand bit lengths leave overflow undecided.
+func Product(vals []*Uint1024) (*Uint1024, bool) {
+	acc := *ONE
+	overflow := false
+
+	for _, v := range vals {
+		if v.IsZero() {
+			return ZERO.Clone(), false
+		}
+
+		// Every factor is at least one, so once the exact product overflows it stays overflowed
+		if !overflow {
+			switch acc.mulBitLenSum(v) {
+			case 1:
+				overflow = true
+			case 0:
+				product := acc.mulFull(v)
+				copy(acc.words[:], product[:16])
+				overflow = !isZeroWords(product[16:])
+				continue
+			}
+		}
+		acc = acc.mulLow(v)
+	}
+
+	return &acc, overflow
+}
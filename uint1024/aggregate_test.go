@@ -0,0 +1,93 @@
+package uint1024
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestSumProduct tests Sum and Product against math/big
+func TestSumProduct(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+	check := func(vals []*Uint1024) {
+		t.Helper()
+		sum, prod := new(big.Int), big.NewInt(1)
+		for _, v := range vals {
+			sum.Add(sum, toBig(v))
+			prod.Mul(prod, toBig(v))
+		}
+
+		got, overflow := Sum(vals)
+		if wantOverflow := sum.Cmp(modulus) >= 0; overflow != wantOverflow || toBig(got).Cmp(sum.Mod(sum, modulus)) != 0 {
+			t.Errorf("Sum of %d values = (%s, %v), want (%x, %v)", len(vals), got.Hex(), overflow, sum, wantOverflow)
+		}
+
+		got, overflow = Product(vals)
+		if wantOverflow := prod.Cmp(modulus) >= 0; overflow != wantOverflow || toBig(got).Cmp(prod.Mod(prod, modulus)) != 0 {
+			t.Errorf("Product of %d values = (%s, %v), want (%x, %v)", len(vals), got.Hex(), overflow, prod, wantOverflow)
+		}
+	}
+
+	// Empty input gives the identities
+	if sum, overflow := Sum(nil); !sum.IsZero() || overflow {
+		t.Errorf("Sum(nil) = (%s, %v), want (0, false)", sum, overflow)
+	}
+	if prod, overflow := Product(nil); !prod.Equal(ONE) || overflow {
+		t.Errorf("Product(nil) = (%s, %v), want (1, false)", prod, overflow)
+	}
+
+	check([]*Uint1024{MAX})
+	check([]*Uint1024{MAX, ONE})                        // Sum carries out exactly
+	check([]*Uint1024{MAX, MAX, MAX})                   // Sum wraps twice
+	check([]*Uint1024{ONE.Shl(512), ONE.Shl(511)})      // Product just fits
+	check([]*Uint1024{ONE.Shl(512), ONE.Shl(512)})      // Product is exactly 2^1024
+	check([]*Uint1024{MAX, MAX, ZERO})                  // A zero factor clears the overflow
+	check([]*Uint1024{ONE.Shl(1000), ONE.Shl(30), ONE}) // Overflow decided by bit lengths alone
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		vals := make([]*Uint1024, r.Intn(20)+1)
+		for j := range vals {
+			vals[j] = FromLimbs(randWords(r, r.Intn(4)+1))
+		}
+		check(vals)
+		check([]*Uint1024{randUint1024(r), randUint1024(r), randUint1024(r)})
+	}
+
+	// The accumulator is not one of the inputs, and nothing is allocated besides the result
+	vals := []*Uint1024{New(2), New(3)}
+	if sum, _ := Sum(vals); sum == vals[0] || !vals[0].Equal(New(2)) {
+		t.Error("Sum modified or returned one of its inputs")
+	}
+	if allocs := testing.AllocsPerRun(10, func() { Sum(vals) }); allocs > 1 {
+		t.Errorf("Sum allocated %v times, want only the result", allocs)
+	}
+	if allocs := testing.AllocsPerRun(10, func() { Product(vals) }); allocs > 1 {
+		t.Errorf("Product allocated %v times, want only the result", allocs)
+	}
+}
+
+// BenchmarkSum compares Sum over a million values with accumulating through Add
+func BenchmarkSum(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	vals := make([]*Uint1024, 1000000)
+	for i := range vals {
+		vals[i] = FromLimbs(randWords(r, 15))
+	}
+
+	b.Run("Sum", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Sum(vals)
+		}
+	})
+	b.Run("Add", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			acc := ZERO.Clone()
+			for _, v := range vals {
+				acc = acc.Add(v)
+			}
+		}
+	})
+}
@@ -31,6 +31,71 @@ func (u *Uint1024) AddInPlace(other *Uint1024) {
 	}
 }
 
+// CheckedAdd performs addition that refuses to wrap: result = a + b.
+// Returns ErrOverflow if the sum does not fit in 1024 bits.
+func (u *Uint1024) CheckedAdd(other *Uint1024) (*Uint1024, error) {
+	result := &Uint1024{}
+	var carry uint64
+
+	for i := range u.words {
+		result.words[i], carry = bits.Add64(u.words[i], other.words[i], carry)
+	}
+
+	if carry != 0 {
+		return nil, ErrOverflow
+	}
+	return result, nil
+}
+
+// SaturatingAdd performs addition clamped at MAX: result = min(a + b, MAX).
+func (u *Uint1024) SaturatingAdd(other *Uint1024) *Uint1024 {
+	if result, err := u.CheckedAdd(other); err == nil {
+		return result
+	}
+	return MAX.Clone()
+}
+
+// CheckedSub performs subtraction that refuses to wrap: result = a - b.
+// Returns ErrUnderflow if b > a.
+func (u *Uint1024) CheckedSub(other *Uint1024) (*Uint1024, error) {
+	result := &Uint1024{}
+	var borrow uint64
+
+	for i := range u.words {
+		result.words[i], borrow = bits.Sub64(u.words[i], other.words[i], borrow)
+	}
+
+	if borrow != 0 {
+		return nil, ErrUnderflow
+	}
+	return result, nil
+}
+
+// SaturatingSub performs subtraction clamped at zero: result = max(a - b, 0).
+func (u *Uint1024) SaturatingSub(other *Uint1024) *Uint1024 {
+	if result, err := u.CheckedSub(other); err == nil {
+		return result
+	}
+	return ZERO.Clone()
+}
+
+// AddSmall performs addition of a single word: result = a + val mod 2^1024.
+// The carry stops propagating at the first word that does not overflow,
+// which is much cheaper than Add(New(val)).
+func (u *Uint1024) AddSmall(val uint64) *Uint1024 {
+	result := u.Clone()
+	result.AddSmallInPlace(val)
+	return result
+}
+
+// AddSmallInPlace performs addition of a single word in place: u = u + val.
+func (u *Uint1024) AddSmallInPlace(val uint64) {
+	carry := val
+	for i := 0; i < len(u.words) && carry != 0; i++ {
+		u.words[i], carry = bits.Add64(u.words[i], carry, 0)
+	}
+}
+
 // Sub performs subtraction: result = a - b.
 func (u *Uint1024) Sub(other *Uint1024) *Uint1024 {
 	result := &Uint1024{}
@@ -56,46 +121,356 @@ func (u *Uint1024) SubInPlace(other *Uint1024) {
 	}
 }
 
-// Mul performs multiplication: result = a * b.
-// Note: This truncates the result to fit in Uint1024.
-// In practice, you might want to return an error or handle overflow differently.
+// Neg performs two's complement negation: result = -a mod 2^1024 = ^a + 1.
+// This is the additive inverse in Z/2^1024Z, so a.Add(b.Neg()) equals a.Sub(b);
+// Neg(0) is 0 and Neg(1) is MAX.
+func (u *Uint1024) Neg() *Uint1024 {
+	result := &Uint1024{}
+	var borrow uint64
+
+	for i := range u.words {
+		result.words[i], borrow = bits.Sub64(0, u.words[i], borrow)
+	}
+
+	return result
+}
+
+// SubSmall performs subtraction of a single word: result = a - val mod 2^1024.
+// Wraps on underflow like Sub; the borrow stops propagating at the first word
+// that does not underflow.
+func (u *Uint1024) SubSmall(val uint64) *Uint1024 {
+	result := u.Clone()
+	result.SubSmallInPlace(val)
+	return result
+}
+
+// SubSmallInPlace performs subtraction of a single word in place: u = u - val.
+func (u *Uint1024) SubSmallInPlace(val uint64) {
+	borrow := val
+	for i := 0; i < len(u.words) && borrow != 0; i++ {
+		u.words[i], borrow = bits.Sub64(u.words[i], borrow, 0)
+	}
+}
+
+// AddInt64 performs addition of a signed word: result = a + delta.
+// Positive deltas are added with wrapping like Add; negative deltas are subtracted,
+// returning an error wrapping ErrUnderflow instead of wrapping if the result would be below zero.
+func (u *Uint1024) AddInt64(delta int64) (*Uint1024, error) {
+	result := u.Clone()
+	if err := result.AddInt64InPlace(delta); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// AddInt64InPlace performs addition of a signed word in place: u = u + delta.
+// On error u is left unchanged.
+func (u *Uint1024) AddInt64InPlace(delta int64) error {
+	if delta >= 0 {
+		u.AddSmallInPlace(uint64(delta))
+		return nil
+	}
+
+	// Negating in uint64 also gives the right magnitude for math.MinInt64,
+	// whose absolute value does not fit in an int64
+	magnitude := -uint64(delta)
+	if u.FitsUint64() && u.words[0] < magnitude {
+		return fmt.Errorf("cannot subtract %d from %s: %w", magnitude, u, ErrUnderflow)
+	}
+	u.SubSmallInPlace(magnitude)
+	return nil
+}
+
+// AbsDiff returns the absolute difference: result = |a - b|.
+// Unlike Sub it never wraps, regardless of the order of the operands.
+func (u *Uint1024) AbsDiff(other *Uint1024) *Uint1024 {
+	if u.Less(other) {
+		return other.Sub(u)
+	}
+	return u.Sub(other)
+}
+
+// AbsDiffInPlace computes the absolute difference in place: u = |u - other|.
+func (u *Uint1024) AbsDiffInPlace(other *Uint1024) {
+	u.absDiffInPlace(other)
+}
+
+// AbsDiffSign returns the absolute difference together with its sign:
+// a - b = -diff if neg, and diff otherwise. neg is false for equal operands.
+func (u *Uint1024) AbsDiffSign(other *Uint1024) (diff *Uint1024, neg bool) {
+	diff = u.Clone()
+	neg = diff.absDiffInPlace(other)
+	return diff, neg
+}
+
+// absDiffInPlace sets u = |u - other| and reports whether other was larger.
+// It subtracts once and negates if the subtraction borrowed, which avoids a
+// separate comparison.
+func (u *Uint1024) absDiffInPlace(other *Uint1024) bool {
+	var borrow uint64
+	for i := range u.words {
+		u.words[i], borrow = bits.Sub64(u.words[i], other.words[i], borrow)
+	}
+	if borrow == 0 {
+		return false
+	}
+
+	// Two's complement negation of the wrapped difference
+	borrow = 0
+	for i := range u.words {
+		u.words[i], borrow = bits.Sub64(0, u.words[i], borrow)
+	}
+	return true
+}
+
+// Mul performs multiplication: result = a * b mod 2^1024.
+// The product wraps: any bits above position 1023 are discarded.
+// Use MulFull to obtain the complete 2048-bit product.
 func (u *Uint1024) Mul(other *Uint1024) *Uint1024 {
+	result := u.mulLow(other)
+	return &result
+}
+
+// mulLow computes the low 1024 bits of the product and returns them by value.
+// It stays schoolbook: the 136 word products below word 16 cost less than the
+// full Karatsuba product (see BenchmarkKaratsuba and BenchmarkSquare).
+func (u *Uint1024) mulLow(other *Uint1024) Uint1024 {
+	var result Uint1024
+
+	for i := range u.words {
+		if u.words[i] == 0 {
+			continue
+		}
+
+		// Only partial products that land below word 16 contribute to the result
+		var carry uint64
+		for j := 0; i+j < len(result.words); j++ {
+			hi, lo := bits.Mul64(u.words[i], other.words[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			result.words[i+j], c = bits.Add64(result.words[i+j], lo, 0)
+			carry = hi + c
+		}
+	}
+
+	return result
+}
+
+// MulChecked performs multiplication and reports truncation: result = a * b mod 2^1024.
+// The boolean reports whether any bits above position 1023 were lost.
+// When the operand bit lengths settle the question the truncated product is
+// computed directly; only near the boundary is the full 2048-bit product needed.
+func (u *Uint1024) MulChecked(other *Uint1024) (*Uint1024, bool) {
+	switch u.mulBitLenSum(other) {
+	case -1:
+		return u.Mul(other), false
+	case 1:
+		return u.Mul(other), true
+	}
+
+	product := u.mulFull(other)
+	return FromLimbs(product[:16]), !isZeroWords(product[16:])
+}
+
+// MulOverflows reports whether a * b does not fit in 1024 bits, without
+// computing the product unless the operand bit lengths leave it undecided.
+func (u *Uint1024) MulOverflows(other *Uint1024) bool {
+	switch u.mulBitLenSum(other) {
+	case -1:
+		return false
+	case 1:
+		return true
+	}
+
+	product := u.mulFull(other)
+	return !isZeroWords(product[16:])
+}
+
+// mulBitLenSum classifies a * b by the operand bit lengths la and lb.
+// The product lies in [2^(la+lb-2), 2^(la+lb)), so it returns -1 if the product
+// certainly fits in 1024 bits (la+lb <= 1024), 1 if it certainly does not
+// (la+lb >= 1026), and 0 if only the exact product can tell.
+func (u *Uint1024) mulBitLenSum(other *Uint1024) int {
+	la, lb := 1024-u.LeadingZeros(), 1024-other.LeadingZeros()
+	if la == 0 || lb == 0 || la+lb <= 1024 {
+		return -1
+	}
+	if la+lb >= 1026 {
+		return 1
+	}
+	return 0
+}
+
+// CheckedMul performs multiplication that refuses to wrap: result = a * b.
+// Returns ErrOverflow if the exact product does not fit in 1024 bits.
+func (u *Uint1024) CheckedMul(other *Uint1024) (*Uint1024, error) {
+	result, overflow := u.MulChecked(other)
+	if overflow {
+		return nil, ErrOverflow
+	}
+	return result, nil
+}
+
+// MulSmall performs multiplication by a single word: result = a * val mod 2^1024.
+// Uses a single carry-propagation pass, which is much cheaper than Mul(New(val)).
+func (u *Uint1024) MulSmall(val uint64) *Uint1024 {
+	result := &Uint1024{}
+	var carry uint64
+
+	for i := range u.words {
+		hi, lo := bits.Mul64(u.words[i], val)
+
+		var c uint64
+		result.words[i], c = bits.Add64(lo, carry, 0)
+		carry = hi + c
+	}
+
+	return result
+}
+
+// MulFull performs full-width multiplication: hi:lo = a * b.
+// The exact 2048-bit product is returned as two halves, where lo holds
+// the least significant 1024 bits and hi the most significant 1024 bits.
+func (u *Uint1024) MulFull(other *Uint1024) (hi, lo *Uint1024) {
+	product := u.mulFull(other)
+
+	hi, lo = &Uint1024{}, &Uint1024{}
+	copy(lo.words[:], product[:16])
+	copy(hi.words[:], product[16:])
+
+	return hi, lo
+}
+
+// mulFull computes the exact 2048-bit product as 32 words in little-endian order.
+// When both operands have at least karatsubaThreshold significant limbs the product
+// is computed with Karatsuba multiplication; otherwise with schoolbook multiplication,
+// which skips the zero limbs of the receiver.
+func (u *Uint1024) mulFull(other *Uint1024) [32]uint64 {
+	var result [32]uint64
+
+	if significantWords(u.words[:]) >= karatsubaThreshold && significantWords(other.words[:]) >= karatsubaThreshold {
+		var scratch [karatsubaScratch]uint64
+		karatsubaMul(result[:], u.words[:], other.words[:], scratch[:])
+		return result
+	}
+
+	basicMul(result[:], u.words[:], other.words[:])
+	return result
+}
+
+// Square performs squaring: result = a * a mod 2^1024.
+// Only the upper triangle of the word products is computed and doubled, with the
+// diagonal added separately, roughly halving the word multiplications of Mul.
+// The boolean reports whether any bits above position 1023 were truncated.
+func (u *Uint1024) Square() (*Uint1024, bool) {
+	square := u.sqrFull()
+
 	result := &Uint1024{}
+	copy(result.words[:], square[:16])
 
+	for _, word := range square[16:] {
+		if word != 0 {
+			return result, true
+		}
+	}
+	return result, false
+}
+
+// Sqr performs squaring: result = a * a mod 2^1024.
+// It wraps exactly like Mul(a), but like Square computes each cross product once.
+func (u *Uint1024) Sqr() *Uint1024 {
+	result := u.sqrLow()
+	return &result
+}
+
+// SqrFull performs full-width squaring: hi:lo = a * a.
+// The exact 2048-bit square is returned as two halves, like MulFull.
+func (u *Uint1024) SqrFull() (hi, lo *Uint1024) {
+	square := u.sqrFull()
+	return FromLimbs(square[16:]), FromLimbs(square[:16])
+}
+
+// sqrLow computes the low 1024 bits of the square and returns them by value.
+// Like mulLow it skips every word product that lands at word 16 or above.
+func (u *Uint1024) sqrLow() Uint1024 {
+	var result Uint1024
+
+	// Accumulate the off-diagonal products a[i]*a[j] for i < j below word 16
 	for i := range u.words {
 		if u.words[i] == 0 {
 			continue
 		}
 
 		var carry uint64
-		for j := 0; j < len(other.words) && i+j < len(result.words); j++ {
-			if other.words[j] == 0 {
-				continue
-			}
+		for j := i + 1; i+j < len(result.words); j++ {
+			hi, lo := bits.Mul64(u.words[i], u.words[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			result.words[i+j], c = bits.Add64(result.words[i+j], lo, 0)
+			carry = hi + c
+		}
+	}
 
-			hi, lo := bits.Mul64(u.words[i], other.words[j])
+	// Double the off-diagonal sum, discarding the bit shifted out of word 15
+	var top uint64
+	for i := range result.words {
+		next := result.words[i] >> 63
+		result.words[i] = result.words[i]<<1 | top
+		top = next
+	}
 
-			// Add lo to result[i+j]
-			sum, c1 := bits.Add64(result.words[i+j], lo, carry)
-			result.words[i+j] = sum
-			carry = c1
+	// Add the diagonal squares a[i]*a[i] that land below word 16
+	var carry uint64
+	for i := 0; 2*i < len(result.words); i++ {
+		hi, lo := bits.Mul64(u.words[i], u.words[i])
+		result.words[2*i], carry = bits.Add64(result.words[2*i], lo, carry)
+		result.words[2*i+1], carry = bits.Add64(result.words[2*i+1], hi, carry)
+	}
 
-			// Add hi to result[i+j+1] if it exists
-			if i+j+1 < len(result.words) {
-				sum, c2 := bits.Add64(result.words[i+j+1], hi, carry)
-				result.words[i+j+1] = sum
-				carry = c2
-			}
+	return result
+}
+
+// sqrFull computes the exact 2048-bit square as 32 words in little-endian order.
+func (u *Uint1024) sqrFull() [32]uint64 {
+	var result [32]uint64
+
+	// Accumulate the off-diagonal products a[i]*a[j] for i < j
+	for i := range u.words {
+		if u.words[i] == 0 {
+			continue
 		}
 
-		// Propagate remaining carry
-		k := i + len(other.words)
-		for carry != 0 && k < len(result.words) {
-			sum, c := bits.Add64(result.words[k], carry, 0)
-			result.words[k] = sum
-			carry = c
-			k++
+		var carry uint64
+		for j := i + 1; j < len(u.words); j++ {
+			hi, lo := bits.Mul64(u.words[i], u.words[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			result[i+j], c = bits.Add64(result[i+j], lo, 0)
+			carry = hi + c
 		}
+		result[i+len(u.words)] = carry
+	}
+
+	// Double the off-diagonal sum; it is below 2^2047, so nothing is shifted out
+	var top uint64
+	for i := range result {
+		next := result[i] >> 63
+		result[i] = result[i]<<1 | top
+		top = next
+	}
+
+	// Add the diagonal squares a[i]*a[i]
+	var carry uint64
+	for i := range u.words {
+		hi, lo := bits.Mul64(u.words[i], u.words[i])
+		result[2*i], carry = bits.Add64(result[2*i], lo, carry)
+		result[2*i+1], carry = bits.Add64(result[2*i+1], hi, carry)
 	}
 
 	return result
@@ -104,74 +479,431 @@ func (u *Uint1024) Mul(other *Uint1024) *Uint1024 {
 // Div performs division: result = a / b.
 // Returns quotient and error (if divisor is zero).
 func (u *Uint1024) Div(other *Uint1024) (*Uint1024, error) {
+	quotient, _, err := u.DivMod(other)
+	return quotient, err
+}
+
+// Mod performs modulo operation: result = a % b.
+func (u *Uint1024) Mod(other *Uint1024) (*Uint1024, error) {
+	_, remainder, err := u.DivMod(other)
+	return remainder, err
+}
+
+// DivMod performs division and modulo in a single pass: a = q * b + r.
+// Div and Mod are thin wrappers around it, so computing both through DivMod
+// costs half as much as calling them separately.
+// Returns quotient, remainder and error (if divisor is zero).
+func (u *Uint1024) DivMod(other *Uint1024) (q, r *Uint1024, err error) {
 	if other.IsZero() {
-		return nil, fmt.Errorf("division by zero")
+		return nil, nil, fmt.Errorf("division by zero")
 	}
 
 	if u.Less(other) {
-		return ZERO.Clone(), nil
+		return ZERO.Clone(), u.Clone(), nil
 	}
 
 	if u.Equal(other) {
+		return ONE.Clone(), ZERO.Clone(), nil
+	}
+
+	// Divide only the significant limbs of each operand
+	qw, rw := divWords(u.words[:significantWords(u.words[:])], other.words[:significantWords(other.words[:])])
+	return FromLimbs(qw), FromLimbs(rw), nil
+}
+
+// DivUint64 performs division by a single word: a = q * d + r.
+// Runs a single pass of 128-by-64-bit divisions over the words, so it is much
+// cheaper than DivMod(New(d)).
+// Returns quotient, remainder and error (if divisor is zero).
+func (u *Uint1024) DivUint64(d uint64) (*Uint1024, uint64, error) {
+	if d == 0 {
+		return nil, 0, fmt.Errorf("division by zero")
+	}
+
+	quotient := &Uint1024{}
+	remainder := divWordsSingle(quotient.words[:], u.words[:], d)
+	return quotient, remainder, nil
+}
+
+// DivSmall performs division by a single word: a = q * divisor + r.
+// Same as DivUint64 but panics if divisor is zero instead of returning an error.
+func (u *Uint1024) DivSmall(divisor uint64) (*Uint1024, uint64) {
+	if divisor == 0 {
+		panic("uint1024: division by zero")
+	}
+
+	quotient := &Uint1024{}
+	remainder := divWordsSingle(quotient.words[:], u.words[:], divisor)
+	return quotient, remainder
+}
+
+// ModUint64 performs modulo by a single word: result = a % d.
+func (u *Uint1024) ModUint64(d uint64) (uint64, error) {
+	if d == 0 {
+		return 0, fmt.Errorf("division by zero")
+	}
+
+	return u.modWord(d), nil
+}
+
+// ModSmall performs modulo by a single word: result = a % divisor.
+// Same as ModUint64 but panics if divisor is zero instead of returning an error.
+// Nothing is allocated, which makes it suitable for trial division.
+func (u *Uint1024) ModSmall(divisor uint64) uint64 {
+	if divisor == 0 {
+		panic("uint1024: division by zero")
+	}
+	return u.modWord(divisor)
+}
+
+// modWord returns u % d for a non-zero word d.
+func (u *Uint1024) modWord(d uint64) uint64 {
+	var remainder uint64
+	for i := len(u.words) - 1; i >= 0; i-- {
+		_, remainder = bits.Div64(remainder, u.words[i], d)
+	}
+	return remainder
+}
+
+// Sqrt returns the integer square root: result = floor(√u).
+// Uses Newton's method x = (x + u/x) / 2 starting from the estimate u >> (BitLen/2).
+// The iteration only divides and never squares an estimate, so no 2048-bit
+// intermediate is needed: every sum x + u/x stays below 2^514 and cannot
+// overflow, which makes the result exact for all inputs up to and including MAX.
+func (u *Uint1024) Sqrt() *Uint1024 {
+	if u.IsZero() {
+		return ZERO.Clone()
+	}
+
+	bitLen := uint(1024 - u.LeadingZeros())
+
+	// A Newton step from any positive estimate lands on or above floor(√u);
+	// from there the estimates decrease until they reach it
+	x := u.Shr(bitLen / 2)
+	next := u.sqrtStep(x)
+	for {
+		x = next
+		next = u.sqrtStep(x)
+		if !next.Less(x) {
+			return x
+		}
+	}
+}
+
+// sqrtStep performs a single Newton iteration for the square root: result = (x + u/x) / 2.
+// x must be non-zero.
+func (u *Uint1024) sqrtStep(x *Uint1024) *Uint1024 {
+	result, _ := u.Div(x)
+	result.AddInPlace(x)
+	result.ShrInPlace(1)
+	return result
+}
+
+// Root returns the integer n-th root: result = floor(u^(1/n)).
+// Uses Newton's method x = ((n-1)x + u/x^(n-1)) / n starting from 2^ceil(BitLen/n),
+// which is never below the root. From above, each step lands on or above the root
+// and the estimates strictly decrease until they reach it, so stopping at the
+// first step that does not decrease cannot oscillate between k and k+1.
+// Returns an error if n is zero.
+func (u *Uint1024) Root(n uint) (*Uint1024, error) {
+	if n == 0 {
+		return nil, fmt.Errorf("zeroth root is undefined")
+	}
+
+	bitLen := uint(1024 - u.LeadingZeros())
+	switch {
+	case n == 1 || bitLen <= 1:
+		return u.Clone(), nil
+	case n >= bitLen:
+		// 1 <= u < 2^n
 		return ONE.Clone(), nil
 	}
 
-	// Use binary long division
-	quotient := ZERO.Clone()
-	remainder := ZERO.Clone()
+	x := ONE.Shl((bitLen + n - 1) / n)
+	for {
+		next := u.rootStep(x, n)
+		if !next.Less(x) {
+			return x, nil
+		}
+		x = next
+	}
+}
 
-	// Process bits from most significant to least significant
-	for i := 1023; i >= 0; i-- {
-		// Shift remainder left by 1
-		remainder.ShlInPlace(1)
+// rootStep performs a single Newton iteration for the n-th root:
+// result = ((n-1)x + u/x^(n-1)) / n. x must be non-zero and at most 2^512.
+// When x^(n-1) exceeds 1024 bits it is larger than u and the quotient is zero.
+func (u *Uint1024) rootStep(x *Uint1024, n uint) *Uint1024 {
+	result := x.MulSmall(uint64(n - 1))
 
-		// Set the least significant bit of remainder to the i-th bit of dividend
-		if u.Bit(i) {
-			remainder.words[0] |= 1
+	power, overflow := ONE.Clone(), false
+	for i := uint(0); i < n-1 && !overflow; i++ {
+		power, overflow = power.MulChecked(x)
+	}
+	if !overflow {
+		q, _ := u.Div(power)
+		result.AddInPlace(q)
+	}
+
+	result, _ = result.DivSmall(uint64(n))
+	return result
+}
+
+// Pow performs exponentiation: result = a^exp mod 2^1024.
+// Same as ExpUint64(uint64(exp)); overflow wraps without error.
+func (u *Uint1024) Pow(exp uint) *Uint1024 {
+	return u.ExpUint64(uint64(exp))
+}
+
+// Exp performs exponentiation by a Uint1024 exponent: result = a^exp mod 2^1024.
+// Uses left-to-right square-and-multiply; overflow wraps without error.
+// 0^0 is defined as 1.
+func (u *Uint1024) Exp(exp *Uint1024) *Uint1024 {
+	result := *ONE
+
+	for i := 1024 - exp.LeadingZeros() - 1; i >= 0; i-- {
+		result = result.sqrLow()
+		if exp.Bit(i) {
+			result = result.mulLow(u)
 		}
+	}
 
-		// If remainder >= divisor, subtract divisor and set quotient bit
-		if !remainder.Less(other) {
-			remainder.SubInPlace(other)
-			quotient.SetBit(i)
+	return &result
+}
+
+// ExpUint64 performs exponentiation by a uint64 exponent: result = a^exp mod 2^1024.
+// Same as Exp(New(exp)).
+func (u *Uint1024) ExpUint64(exp uint64) *Uint1024 {
+	result := *ONE
+
+	for i := bits.Len64(exp) - 1; i >= 0; i-- {
+		result = result.sqrLow()
+		if exp&(1<<uint(i)) != 0 {
+			result = result.mulLow(u)
 		}
 	}
 
-	return quotient, nil
+	return &result
 }
 
-// Mod performs modulo operation: result = a % b.
-func (u *Uint1024) Mod(other *Uint1024) (*Uint1024, error) {
-	if other.IsZero() {
+// AddMod performs modular addition: result = (a + b) % mod.
+// Both operands must already be reduced (less than mod), so the sum is below 2*mod
+// and a single conditional subtraction replaces the division; the carry out of
+// bit 1023 is taken into account, so mod may use the full width.
+// Panics if mod is zero.
+func (u *Uint1024) AddMod(other, mod *Uint1024) *Uint1024 {
+	if mod.IsZero() {
+		panic("uint1024: zero modulus")
+	}
+
+	result := &Uint1024{}
+	var carry uint64
+	for i := range u.words {
+		result.words[i], carry = bits.Add64(u.words[i], other.words[i], carry)
+	}
+
+	if carry != 0 || !result.Less(mod) {
+		result.SubInPlace(mod)
+	}
+	return result
+}
+
+// SubMod performs modular subtraction: result = (a - b) % mod.
+// Both operands must already be reduced (less than mod); if a < b the wrapped
+// difference is corrected by a single addition of mod instead of a division.
+// Panics if mod is zero.
+func (u *Uint1024) SubMod(other, mod *Uint1024) *Uint1024 {
+	if mod.IsZero() {
+		panic("uint1024: zero modulus")
+	}
+
+	result := &Uint1024{}
+	var borrow uint64
+	for i := range u.words {
+		result.words[i], borrow = bits.Sub64(u.words[i], other.words[i], borrow)
+	}
+
+	if borrow != 0 {
+		result.AddInPlace(mod)
+	}
+	return result
+}
+
+// PowMod performs modular exponentiation: result = a^exp % mod.
+// Intermediate values are reduced at every step, so they never exceed 1024 bits.
+// Returns an error if mod is zero.
+func (u *Uint1024) PowMod(exp uint, mod *Uint1024) (*Uint1024, error) {
+	return u.ModExp(New(uint64(exp)), mod)
+}
+
+// ModExp performs modular exponentiation by a Uint1024 exponent: result = a^exp % mod.
+// Uses left-to-right square-and-multiply, reducing each 2048-bit product with
+// word-based long division. An exponent of zero gives one, except modulo one,
+// where every result is zero.
+// Returns an error if mod is zero.
+func (u *Uint1024) ModExp(exp, mod *Uint1024) (*Uint1024, error) {
+	if mod.IsZero() {
 		return nil, fmt.Errorf("division by zero")
 	}
 
-	if u.Less(other) {
-		return u.Clone(), nil
+	var wide [32]uint64
+	copy(wide[:], u.words[:])
+	base := reduceWide(&wide, mod)
+
+	// Start from 1 % mod, which is zero when mod is one
+	result := ONE.Clone()
+	if mod.Equal(ONE) {
+		result = ZERO.Clone()
 	}
 
-	if u.Equal(other) {
-		return ZERO.Clone(), nil
+	for i := 1024 - exp.LeadingZeros() - 1; i >= 0; i-- {
+		result = result.mulMod(result, mod)
+		if exp.Bit(i) {
+			result = result.mulMod(base, mod)
+		}
+	}
+
+	return result, nil
+}
+
+// ExtendedGCD computes g = gcd(a, b) and Bézout coefficients with
+// a*x*(-1 if xNeg) + b*y*(-1 if yNeg) == g.
+// The coefficients come from the extended Euclidean algorithm, whose coefficients
+// alternate in sign, so only their magnitudes are tracked and the signs follow
+// from the number of steps. The magnitudes are bounded by |x| <= max(1, b/(2g))
+// and |y| <= max(1, a/(2g)), so they always fit in 1024 bits; at most one of the
+// coefficients is negative.
+// ExtendedGCD(a, 0) is (a, 1, 0) and ExtendedGCD(0, 0) is (0, 1, 0).
+func (u *Uint1024) ExtendedGCD(other *Uint1024) (g *Uint1024, x, y *Uint1024, xNeg, yNeg bool) {
+	r0, r1 := u.Clone(), other.Clone()
+	s0, s1 := ONE.Clone(), ZERO.Clone()
+	t0, t1 := ZERO.Clone(), ONE.Clone()
+
+	// After k steps s0 is negative for odd k and t0 for even k > 0
+	steps := 0
+	for !r1.IsZero() {
+		q, r, _ := r0.DivMod(r1)
+		r0, r1 = r1, r
+		s0, s1 = s1, s0.Add(q.Mul(s1))
+		t0, t1 = t1, t0.Add(q.Mul(t1))
+		steps++
+	}
+
+	xNeg = steps%2 == 1 && !s0.IsZero()
+	yNeg = steps%2 == 0 && !t0.IsZero()
+	return r0, s0, t0, xNeg, yNeg
+}
+
+// ModInverse computes the modular inverse: result = x with (a * x) % mod == 1.
+// Odd moduli use the binary extended Euclidean algorithm, which only shifts and
+// subtracts. For even moduli a must be odd, so the inverse y of mod modulo a is
+// computed instead and converted with x = mod - (mod*y - 1)/a.
+// Returns ErrNotInvertible if gcd(a, mod) != 1 or mod is zero or one.
+func (u *Uint1024) ModInverse(mod *Uint1024) (*Uint1024, error) {
+	if mod.IsZero() || mod.Equal(ONE) {
+		return nil, ErrNotInvertible
 	}
+	if mod.IsOdd() {
+		a, _ := u.Mod(mod)
+		return a.modInverseOdd(mod)
+	}
+	if u.IsEven() {
+		return nil, ErrNotInvertible
+	}
+	if u.Equal(ONE) {
+		return ONE.Clone(), nil
+	}
+
+	// mod*y = 1 + k*a for some k, so a*(-k) = 1 (mod mod) with -k = mod - (mod*y - 1)/a
+	m, _ := mod.Mod(u)
+	y, err := m.modInverseOdd(u)
+	if err != nil {
+		return nil, err
+	}
+
+	product := mod.mulFull(y)
+	subWords(product[:], []uint64{1})
+	q, _ := divWords(product[:significantWords(product[:])], u.words[:significantWords(u.words[:])])
+	return mod.Sub(FromLimbs(q)), nil
+}
 
-	// Use binary long division to compute remainder
-	remainder := ZERO.Clone()
+// modInverseOdd computes the inverse of u modulo the odd mod > 1, where u < mod.
+// Keeps x1*a = u and x2*a = v (mod m) while shrinking u and v towards their
+// gcd: even values are halved, halving the coefficient modulo m (adding m first
+// if it is odd), and the smaller value is subtracted from the larger.
+func (u *Uint1024) modInverseOdd(mod *Uint1024) (*Uint1024, error) {
+	a, v := u.Clone(), mod.Clone()
+	x1, x2 := ONE.Clone(), ZERO.Clone()
+
+	for !a.Equal(ONE) && !v.Equal(ONE) {
+		// gcd(a, m) = v > 1 once a reaches zero
+		if a.IsZero() {
+			return nil, ErrNotInvertible
+		}
 
-	// Process bits from most significant to least significant
-	for i := 1023; i >= 0; i-- {
-		// Shift remainder left by 1
-		remainder.ShlInPlace(1)
+		for a.IsEven() {
+			a.ShrInPlace(1)
+			x1.halveMod(mod)
+		}
+		for v.IsEven() {
+			v.ShrInPlace(1)
+			x2.halveMod(mod)
+		}
 
-		// Set the least significant bit of remainder to the i-th bit of dividend
-		if u.Bit(i) {
-			remainder.words[0] |= 1
+		if a.GreaterOrEqual(v) {
+			a.SubInPlace(v)
+			x1 = x1.SubMod(x2, mod)
+		} else {
+			v.SubInPlace(a)
+			x2 = x2.SubMod(x1, mod)
 		}
+	}
 
-		// If remainder >= divisor, subtract divisor
-		if !remainder.Less(other) {
-			remainder.SubInPlace(other)
+	if a.Equal(ONE) {
+		return x1, nil
+	}
+	return x2, nil
+}
+
+// halveMod sets u = u / 2 mod m for u < m and odd m, adding m first if u is odd;
+// the carry out of that addition becomes the top bit after the shift.
+func (u *Uint1024) halveMod(mod *Uint1024) {
+	var carry uint64
+	if u.IsOdd() {
+		for i := range u.words {
+			u.words[i], carry = bits.Add64(u.words[i], mod.words[i], carry)
 		}
 	}
+	u.ShrInPlace(1)
+	u.words[15] |= carry << 63
+}
+
+// MulMod performs modular multiplication: result = (a * b) % mod.
+// The exact 2048-bit product is reduced with word-based long division, so no bits
+// are lost and the operands need not be reduced beforehand.
+// Returns an error if mod is zero.
+func (u *Uint1024) MulMod(other, mod *Uint1024) (*Uint1024, error) {
+	if mod.IsZero() {
+		return nil, fmt.Errorf("division by zero")
+	}
+	return u.mulMod(other, mod), nil
+}
+
+// mulMod computes (a * b) % mod using the full 2048-bit product, so no bits are lost.
+// mod must be non-zero.
+func (u *Uint1024) mulMod(other, mod *Uint1024) *Uint1024 {
+	product := u.mulFull(other)
+	return reduceWide(&product, mod)
+}
+
+// reduceWide computes x % mod for a 2048-bit value x given as 32 little-endian words.
+// mod must be non-zero.
+func reduceWide(x *[32]uint64, mod *Uint1024) *Uint1024 {
+	xn := significantWords(x[:])
+	modn := significantWords(mod.words[:])
+	if xn < modn {
+		return FromLimbs(x[:xn])
+	}
 
-	return remainder, nil
+	_, rw := divWords(x[:xn], mod.words[:modn])
+	return FromLimbs(rw)
 }
@@ -0,0 +1,133 @@
+package uint1024
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestBarrettExhaustive checks every dividend below mod^2 + mod for every small modulus
+func TestBarrettExhaustive(t *testing.T) {
+	for m := uint64(1); m <= 100; m++ {
+		br, err := NewBarrett(New(m))
+		if err != nil {
+			t.Fatalf("NewBarrett(%d): unexpected error %v", m, err)
+		}
+		for x := uint64(0); x < m*m+m; x++ {
+			if got := br.Reduce(ZERO, New(x)); !got.Equal(New(x % m)) {
+				t.Fatalf("%d mod %d = %s, want %d", x, m, got, x%m)
+			}
+		}
+	}
+}
+
+// TestBarrett cross-checks Reduce and MulMod against math/big and counts how
+// often each correction branch is taken
+func TestBarrett(t *testing.T) {
+	if _, err := NewBarrett(ZERO); err == nil {
+		t.Error("NewBarrett(0): expected error")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	moduli := []*Uint1024{ONE, New(2), New(3), ONE.Shl(64), ONE.Shl(512), ONE.Shl(1023), MAX, MAX.Shr(1), fromBig(oakleyPrime())}
+	for i := 0; i < 30; i++ {
+		moduli = append(moduli, FromLimbs(randWords(r, r.Intn(16)+1)))
+	}
+
+	var corrections [3]int
+	for _, m := range moduli {
+		br, err := NewBarrett(m)
+		if err != nil {
+			t.Fatalf("NewBarrett(%s): unexpected error %v", m, err)
+		}
+		if !br.Modulus().Equal(m) {
+			t.Errorf("Modulus() = %s, want %s", br.Modulus(), m)
+		}
+
+		// Dividends of every length up to 32 words, including ones wider than 2k words
+		for i := 0; i < 50; i++ {
+			var x [32]uint64
+			copy(x[:], randWords(r, r.Intn(32)+1))
+			hi, lo := FromLimbs(x[16:]), FromLimbs(x[:16])
+
+			want := new(big.Int).Mod(limbsToBig(x[:]), toBig(m))
+			if got := br.Reduce(hi, lo); toBig(got).Cmp(want) != 0 {
+				t.Errorf("%x mod %s = %s, want %s", limbsToBig(x[:]), m, got, want)
+			}
+
+			_, n := br.reduce(&x)
+			if n > 2 {
+				t.Errorf("%x mod %s took %d corrections, want at most 2", limbsToBig(x[:]), m, n)
+				continue
+			}
+			corrections[n]++
+		}
+
+		for i := 0; i < 20; i++ {
+			a, b := randUint1024(r), randUint1024(r)
+			want := new(big.Int).Mul(toBig(a), toBig(b))
+			want.Mod(want, toBig(m))
+			if got := br.MulMod(a, b); toBig(got).Cmp(want) != 0 {
+				t.Errorf("(%s * %s) mod %s = %s, want %s", a, b, m, got, want)
+			}
+		}
+	}
+
+	// The quotient estimate is two too small only when the truncations of the
+	// dividend and of μ both lose almost a whole unit: a two-word modulus just
+	// above 2^64 with a dividend just below b^4 whose low word is all ones
+	for _, tc := range []struct{ m, x []uint64 }{
+		{[]uint64{0xa372db9, 1}, []uint64{0xffffffffffffffff, 0xfff8e1e0107532ed, 0xffffffffffffffff, 0xffffffffffffffff}},
+		{[]uint64{0x1a11e376531d6461, 1}, []uint64{0xffffffffffffffff, 0x623724b717913639, 0xfffffffffddadab8, 0xffffffffffffffff}},
+	} {
+		br, _ := NewBarrett(FromLimbs(tc.m))
+		var x [32]uint64
+		copy(x[:], tc.x)
+
+		want := new(big.Int).Mod(limbsToBig(tc.x), limbsToBig(tc.m))
+		got, n := br.reduce(&x)
+		if toBig(got).Cmp(want) != 0 || n != 2 {
+			t.Errorf("%x mod %x = %s with %d corrections, want %s with 2", limbsToBig(tc.x), limbsToBig(tc.m), got, n, want)
+		}
+	}
+
+	// Two corrections are covered by the hand-picked cases above
+	for n, count := range corrections[:2] {
+		if count == 0 {
+			t.Errorf("no reduction took %d corrections", n)
+		}
+	}
+}
+
+// BenchmarkBarrett compares Barrett reduction of a 2048-bit product against MulMod,
+// which reduces by long division
+func BenchmarkBarrett(b *testing.B) {
+	m := fromBig(oakleyPrime())
+	x, y := MAX.Sub(New(12345)), MAX.Sub(New(67890))
+	br, _ := NewBarrett(m)
+
+	b.Run("Barrett", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			br.MulMod(x, y)
+		}
+	})
+	b.Run("MulMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.MulMod(y, m)
+		}
+	})
+
+	// An even 512-bit modulus, for which Montgomery reduction does not apply
+	even := MAX.Shr(512).Sub(New(1))
+	brEven, _ := NewBarrett(even)
+	b.Run("Reduce512", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			brEven.Reduce(ZERO, x)
+		}
+	})
+	b.Run("Mod512", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.Mod(even)
+		}
+	})
+}
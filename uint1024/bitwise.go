@@ -1,7 +1,10 @@
 // bitwise.go implements bitwise operations for Uint1024
 package uint1024
 
-import "math/bits"
+import (
+	"fmt"
+	"math/bits"
+)
 
 // And performs bitwise AND: result = a & b.
 func (u *Uint1024) And(other *Uint1024) *Uint1024 {
@@ -157,6 +160,26 @@ func (u *Uint1024) ShrInPlace(n uint) {
 	}
 }
 
+// ReverseBits returns the value with its bit order reversed: bit i moves to bit 1023-i.
+// The word order is reversed and each word is bit-reversed.
+func (u *Uint1024) ReverseBits() *Uint1024 {
+	result := &Uint1024{}
+	for i, word := range u.words {
+		result.words[len(u.words)-1-i] = bits.Reverse64(word)
+	}
+	return result
+}
+
+// ReverseBytes returns the value with its byte order reversed: byte i moves to
+// byte 127-i. It equals FromBeBytes(u.ToLeBytes()) without allocating a byte slice.
+func (u *Uint1024) ReverseBytes() *Uint1024 {
+	result := &Uint1024{}
+	for i, word := range u.words {
+		result.words[len(u.words)-1-i] = bits.ReverseBytes64(word)
+	}
+	return result
+}
+
 // Bit returns the value of the bit at position i (0 is least significant).
 func (u *Uint1024) Bit(i int) bool {
 	if i < 0 || i >= 1024 {
@@ -197,7 +220,7 @@ func (u *Uint1024) FlipBit(i int) {
 	u.words[wordIndex] ^= (1 << bitIndex)
 }
 
-// LeadingZeros returns the number of leading zero bits.
+// LeadingZeros returns the number of leading zero bits, or 1024 for zero.
 func (u *Uint1024) LeadingZeros() int {
 	for i := len(u.words) - 1; i >= 0; i-- {
 		if u.words[i] != 0 {
@@ -207,7 +230,23 @@ func (u *Uint1024) LeadingZeros() int {
 	return 1024
 }
 
-// TrailingZeros returns the number of trailing zero bits.
+// BitLen returns the number of bits needed to represent the value:
+// the position of the highest set bit plus one, or 0 for zero.
+func (u *Uint1024) BitLen() int {
+	return 1024 - u.LeadingZeros()
+}
+
+// Log2 returns the integer base-2 logarithm: result = floor(log2(u)) = BitLen() - 1.
+// Returns an error if the value is zero.
+func (u *Uint1024) Log2() (uint, error) {
+	n := u.BitLen()
+	if n == 0 {
+		return 0, fmt.Errorf("logarithm of zero")
+	}
+	return uint(n - 1), nil
+}
+
+// TrailingZeros returns the number of trailing zero bits, or 1024 for zero.
 func (u *Uint1024) TrailingZeros() int {
 	for i := 0; i < len(u.words); i++ {
 		if u.words[i] != 0 {
@@ -217,7 +256,7 @@ func (u *Uint1024) TrailingZeros() int {
 	return 1024
 }
 
-// OnesCount returns the number of one bits (population count).
+// OnesCount returns the number of one bits (population count), or 0 for zero.
 func (u *Uint1024) OnesCount() int {
 	count := 0
 	for _, word := range u.words {
@@ -88,3 +88,28 @@ func (u *Uint1024) Max(other *Uint1024) *Uint1024 {
 	}
 	return other.Clone()
 }
+
+// Clamp constrains the value to the inclusive range [lo, hi]: it returns lo if
+// u < lo, hi if u > hi, and a copy of u otherwise.
+// Panics if lo > hi, since an empty range is a programming error.
+func (u *Uint1024) Clamp(lo, hi *Uint1024) *Uint1024 {
+	if lo.Greater(hi) {
+		panic("uint1024: Clamp with lo > hi")
+	}
+	if u.Less(lo) {
+		return lo.Clone()
+	}
+	if u.Greater(hi) {
+		return hi.Clone()
+	}
+	return u.Clone()
+}
+
+// InRange returns true if lo <= u <= hi.
+// Panics if lo > hi, like Clamp.
+func (u *Uint1024) InRange(lo, hi *Uint1024) bool {
+	if lo.Greater(hi) {
+		panic("uint1024: InRange with lo > hi")
+	}
+	return !u.Less(lo) && !u.Greater(hi)
+}
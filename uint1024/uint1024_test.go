@@ -1,6 +1,10 @@
 package uint1024
 
 import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
 	"testing"
 )
 
@@ -167,6 +171,57 @@ func TestComparison(t *testing.T) {
 	}
 }
 
+// TestClamp tests Clamp and InRange at and around both bounds
+func TestClamp(t *testing.T) {
+	lo, hi := New(10), ONE.Shl(1000)
+	tests := []struct {
+		name    string
+		u, want *Uint1024
+		inRange bool
+	}{
+		{"Below", New(3), lo, false},
+		{"Zero", ZERO, lo, false},
+		{"Lower bound", New(10), lo, true},
+		{"Inside", New(11), New(11), true},
+		{"Upper bound", hi, hi, true},
+		{"Just above", hi.Add(ONE), hi, false},
+		{"MAX", MAX, hi, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.u.Clamp(lo, hi)
+			if !got.Equal(tt.want) {
+				t.Errorf("Clamp(%s) = %s, want %s", tt.u.Hex(), got.Hex(), tt.want.Hex())
+			}
+			if got == tt.u || got == lo || got == hi {
+				t.Error("Clamp returned one of its arguments instead of a copy")
+			}
+			if tt.u.InRange(lo, hi) != tt.inRange {
+				t.Errorf("InRange(%s) = %v, want %v", tt.u.Hex(), !tt.inRange, tt.inRange)
+			}
+		})
+	}
+
+	// A single-value range
+	if got := MAX.Clamp(ONE, ONE); !got.Equal(ONE) || !ONE.InRange(ONE, ONE) {
+		t.Errorf("Clamp(MAX, 1, 1) = %s, want 1", got.Hex())
+	}
+
+	for name, f := range map[string]func(){
+		"Clamp":   func() { ONE.Clamp(hi, lo) },
+		"InRange": func() { ONE.InRange(hi, lo) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s with lo > hi: expected panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
 // TestStringConversion tests string representation
 func TestStringConversion(t *testing.T) {
 	tests := []struct {
@@ -236,6 +291,43 @@ func TestBitOperations(t *testing.T) {
 	}
 }
 
+// TestBitInspection tests BitLen, Log2, LeadingZeros, TrailingZeros and OnesCount
+// on both sides of every word boundary and on zero
+func TestBitInspection(t *testing.T) {
+	if ZERO.BitLen() != 0 || ZERO.LeadingZeros() != 1024 || ZERO.TrailingZeros() != 1024 || ZERO.OnesCount() != 0 {
+		t.Errorf("zero: BitLen %d, LeadingZeros %d, TrailingZeros %d, OnesCount %d; want 0, 1024, 1024, 0",
+			ZERO.BitLen(), ZERO.LeadingZeros(), ZERO.TrailingZeros(), ZERO.OnesCount())
+	}
+	if _, err := ZERO.Log2(); err == nil {
+		t.Error("Log2(0): expected error")
+	}
+	if MAX.BitLen() != 1024 || MAX.OnesCount() != 1024 {
+		t.Errorf("MAX: BitLen %d, OnesCount %d; want 1024, 1024", MAX.BitLen(), MAX.OnesCount())
+	}
+
+	for i := 0; i < 1024; i++ {
+		// 2^i and 2^(i+1) - 1 both have bit length i + 1
+		power, mask := ONE.Shl(uint(i)), MAX.Shr(uint(1023-i))
+		for _, u := range []*Uint1024{power, mask} {
+			if got := u.BitLen(); got != i+1 {
+				t.Errorf("BitLen(%s) = %d, want %d", u.Hex(), got, i+1)
+			}
+			if got := u.LeadingZeros(); got != 1023-i {
+				t.Errorf("LeadingZeros(%s) = %d, want %d", u.Hex(), got, 1023-i)
+			}
+			if got, err := u.Log2(); err != nil || got != uint(i) {
+				t.Errorf("Log2(%s) = (%d, %v), want %d", u.Hex(), got, err, i)
+			}
+		}
+		if got := power.TrailingZeros(); got != i {
+			t.Errorf("TrailingZeros(2^%d) = %d, want %d", i, got, i)
+		}
+		if got := mask.OnesCount(); got != i+1 {
+			t.Errorf("OnesCount(2^%d - 1) = %d, want %d", i+1, got, i+1)
+		}
+	}
+}
+
 // TestShiftOperations tests shift operations
 func TestShiftOperations(t *testing.T) {
 	// Test left shift
@@ -255,6 +347,79 @@ func TestShiftOperations(t *testing.T) {
 	}
 }
 
+// TestReverseBytes tests byte reversal against the byte-slice conversions
+func TestReverseBytes(t *testing.T) {
+	if got := ONE.ReverseBytes(); !got.Equal(ONE.Shl(1016)) {
+		t.Errorf("ReverseBytes(1) = %s, want 2^1016", got.Hex())
+	}
+	if got := MAX.ReverseBytes(); !got.Equal(MAX) {
+		t.Errorf("ReverseBytes(MAX) = %s, want MAX", got.Hex())
+	}
+
+	r := rand.New(rand.NewSource(1))
+
+	// Reading any 128 bytes as little-endian and swapping matches reading them as big-endian
+	data := make([]byte, 128)
+	for i := 0; i < 50; i++ {
+		r.Read(data)
+		if got, want := FromLeBytes(data).ReverseBytes(), FromBeBytes(data); !got.Equal(want) {
+			t.Errorf("FromLeBytes(%x).ReverseBytes() = %s, want %s", data, got.Hex(), want.Hex())
+		}
+	}
+	for i := range data {
+		data[i] = byte(i)
+	}
+	if got, want := FromLeBytes(data).ReverseBytes(), FromBeBytes(data); !got.Equal(want) {
+		t.Errorf("FromLeBytes(0..127).ReverseBytes() = %s, want %s", got.Hex(), want.Hex())
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { MAX.ReverseBytes() }); allocs > 1 {
+		t.Errorf("ReverseBytes allocated %v times, want only the result", allocs)
+	}
+
+	for i := 0; i < 50; i++ {
+		u := randUint1024(r)
+		got := u.ReverseBytes()
+		if want := FromBeBytes(u.ToLeBytes()); !got.Equal(want) {
+			t.Errorf("ReverseBytes(%s) = %s, want %s", u.Hex(), got.Hex(), want.Hex())
+		}
+		if !got.ReverseBytes().Equal(u) {
+			t.Errorf("ReverseBytes(ReverseBytes(%s)) = %s", u.Hex(), got.ReverseBytes().Hex())
+		}
+	}
+}
+
+// TestReverseBits tests bit reversal against a bit-by-bit reference
+func TestReverseBits(t *testing.T) {
+	if got := ONE.ReverseBits(); !got.Equal(ONE.Shl(1023)) {
+		t.Errorf("ReverseBits(1) = %s, want 2^1023", got.Hex())
+	}
+	if got := MAX.ReverseBits(); !got.Equal(MAX) {
+		t.Errorf("ReverseBits(MAX) = %s, want MAX", got.Hex())
+	}
+	if got := ZERO.ReverseBits(); !got.IsZero() {
+		t.Errorf("ReverseBits(0) = %s, want 0", got.Hex())
+	}
+	// A pattern confined to the low word lands reversed in the top word
+	if got := New(0x1234).ReverseBits(); !got.Equal(New(0x2c48).Shl(1008)) {
+		t.Errorf("ReverseBits(0x1234) = %s", got.Hex())
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		u := randUint1024(r)
+		got := u.ReverseBits()
+		for j := 0; j < 1024; j++ {
+			if got.Bit(j) != u.Bit(1023-j) {
+				t.Fatalf("ReverseBits(%s) = %s: bit %d differs from bit %d of the input", u.Hex(), got.Hex(), j, 1023-j)
+			}
+		}
+		if !got.ReverseBits().Equal(u) {
+			t.Errorf("ReverseBits(ReverseBits(%s)) = %s", u.Hex(), got.ReverseBits().Hex())
+		}
+	}
+}
+
 // TestLargeNumbers tests operations with large numbers
 func TestLargeNumbers(t *testing.T) {
 	// Test with maximum uint64 value
@@ -306,3 +471,1481 @@ func TestDivision(t *testing.T) {
 		t.Error("Division by zero should return error")
 	}
 }
+
+// TestMulFull tests the full-width 2048-bit product against math/big
+func TestMulFull(t *testing.T) {
+	check := func(a, b *Uint1024) {
+		t.Helper()
+		hi, lo := a.MulFull(b)
+
+		want := new(big.Int).Mul(toBig(a), toBig(b))
+		got := new(big.Int).Lsh(toBig(hi), 1024)
+		got.Or(got, toBig(lo))
+		if got.Cmp(want) != 0 {
+			t.Errorf("MulFull(%s, %s) = %s:%s, want %s", a.Hex(), b.Hex(), hi.Hex(), lo.Hex(), want.Text(16))
+		}
+
+		// The low half must match the wrapping Mul
+		if !lo.Equal(a.Mul(b)) {
+			t.Errorf("MulFull low half differs from Mul for %s * %s", a.Hex(), b.Hex())
+		}
+	}
+
+	// High half is zero
+	hi, lo := New(1 << 40).MulFull(New(1 << 40))
+	if !hi.IsZero() || !lo.Equal(ONE.Shl(80)) {
+		t.Errorf("2^40 * 2^40: got %s:%s, want 0:2^80", hi.Hex(), lo.Hex())
+	}
+
+	// MAX * MAX = 2^2048 - 2^1025 + 1, so the high half is MAX - 1 and the low half is 1
+	hi, lo = MAX.MulFull(MAX)
+	if !hi.Equal(MAX.Sub(ONE)) || !lo.Equal(ONE) {
+		t.Errorf("MAX * MAX: got %s:%s", hi.Hex(), lo.Hex())
+	}
+
+	// MAX * 2^1023 has an all-ones high half except the top bit
+	check(MAX, ONE.Shl(1023))
+	check(MAX, MAX)
+	check(ZERO, MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		check(randUint1024(r), randUint1024(r))
+	}
+}
+
+// TestAbsDiff tests the absolute difference
+func TestAbsDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     *Uint1024
+		expected *Uint1024
+	}{
+		{"Greater", New(100), New(30), New(70)},
+		{"Less", New(30), New(100), New(70)},
+		{"Equal", New(42), New(42), ZERO.Clone()},
+		{"Full range", ZERO, MAX, MAX.Clone()},
+		{"Large operands", MAX, ONE.Shl(1023), ONE.Shl(1023).Sub(ONE)},
+		{"Top limb only", ONE.Shl(1000), ONE.Shl(1001), ONE.Shl(1000)},
+		{"Top limb decides", MAX.Shr(64), ONE.Shl(960), ONE.Shl(960).Sub(MAX.Shr(64))},
+		{"Equal large", MAX, MAX, ZERO.Clone()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := tt.a.AbsDiff(tt.b); !result.Equal(tt.expected) {
+				t.Errorf("AbsDiff(%s, %s) = %s, want %s", tt.a.Hex(), tt.b.Hex(), result.Hex(), tt.expected.Hex())
+			}
+
+			inPlace := tt.a.Clone()
+			inPlace.AbsDiffInPlace(tt.b)
+			if !inPlace.Equal(tt.expected) {
+				t.Errorf("AbsDiffInPlace(%s, %s) = %s, want %s", tt.a.Hex(), tt.b.Hex(), inPlace.Hex(), tt.expected.Hex())
+			}
+
+			diff, neg := tt.a.AbsDiffSign(tt.b)
+			if !diff.Equal(tt.expected) || neg != tt.a.Less(tt.b) {
+				t.Errorf("AbsDiffSign(%s, %s) = (%s, %v), want (%s, %v)", tt.a.Hex(), tt.b.Hex(), diff.Hex(), neg, tt.expected.Hex(), tt.a.Less(tt.b))
+			}
+		})
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a, b := randUint1024(r), randUint1024(r)
+		want := new(big.Int).Sub(toBig(a), toBig(b))
+		diff, neg := a.AbsDiffSign(b)
+		if toBig(diff).Cmp(new(big.Int).Abs(want)) != 0 || neg != (want.Sign() < 0) {
+			t.Errorf("AbsDiffSign(%s, %s) = (%s, %v), want %s", a.Hex(), b.Hex(), diff.Hex(), neg, want.Text(16))
+		}
+	}
+}
+
+// TestNeg tests two's complement negation
+func TestNeg(t *testing.T) {
+	if !ZERO.Neg().IsZero() {
+		t.Errorf("Neg(0) = %s, want 0", ZERO.Neg().Hex())
+	}
+	if !ONE.Neg().Equal(MAX) || !MAX.Neg().Equal(ONE) {
+		t.Error("Neg(1) should be MAX and Neg(MAX) should be 1")
+	}
+	// 2^1023 is its own negation
+	if half := ONE.Shl(1023); !half.Neg().Equal(half) {
+		t.Errorf("Neg(2^1023) = %s, want itself", half.Neg().Hex())
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a, b := randUint1024(r), randUint1024(r)
+		if !a.Neg().Equal(a.Not().Add(ONE)) {
+			t.Errorf("Neg(%s) = %s, want ^a + 1", a.Hex(), a.Neg().Hex())
+		}
+		if !a.Add(a.Neg()).IsZero() || !a.Neg().Neg().Equal(a) {
+			t.Errorf("Neg(%s) = %s is not the additive inverse", a.Hex(), a.Neg().Hex())
+		}
+		if !a.Add(b.Neg()).Equal(a.Sub(b)) {
+			t.Errorf("%s + Neg(%s) = %s, want %s", a.Hex(), b.Hex(), a.Add(b.Neg()).Hex(), a.Sub(b).Hex())
+		}
+	}
+}
+
+// TestSqrt tests the integer square root
+func TestSqrt(t *testing.T) {
+	// check verifies r*r <= u < (r+1)*(r+1) using the full-width product
+	check := func(u *Uint1024) {
+		t.Helper()
+		r := u.Sqrt()
+
+		hi, lo := r.MulFull(r)
+		if !hi.IsZero() || lo.Greater(u) {
+			t.Errorf("Sqrt(%s) = %s is too large", u.Hex(), r.Hex())
+		}
+		next := r.Add(ONE)
+		hi, lo = next.MulFull(next)
+		if hi.IsZero() && lo.LessOrEqual(u) {
+			t.Errorf("Sqrt(%s) = %s is too small", u.Hex(), r.Hex())
+		}
+	}
+
+	if !ZERO.Sqrt().IsZero() {
+		t.Error("Sqrt(0) should be 0")
+	}
+	if !ONE.Sqrt().Equal(ONE) {
+		t.Error("Sqrt(1) should be 1")
+	}
+
+	for _, root := range []*Uint1024{New(2), New(3), New(1000), New(^uint64(0)), ONE.Shl(400), MAX.Shr(512)} {
+		square := root.Mul(root)
+		if !square.Sqrt().Equal(root) {
+			t.Errorf("Sqrt(%s^2) = %s, want %s", root.Hex(), square.Sqrt().Hex(), root.Hex())
+		}
+		check(square)
+		check(square.Sub(ONE))
+		check(square.Add(ONE))
+	}
+
+	// floor(√(2^1024 - 1)) = 2^512 - 1
+	if !MAX.Sqrt().Equal(MAX.Shr(512)) {
+		t.Errorf("Sqrt(MAX) = %s, want %s", MAX.Sqrt().Hex(), MAX.Shr(512).Hex())
+	}
+	check(MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		check(randUint1024(r))
+	}
+}
+
+// TestRoot verifies r^n <= u < (r+1)^n with exact powers from math/big
+func TestRoot(t *testing.T) {
+	check := func(u *Uint1024, n uint) {
+		t.Helper()
+		r, err := u.Root(n)
+		if err != nil {
+			t.Fatalf("Root(%s, %d): unexpected error %v", u.Hex(), n, err)
+		}
+
+		exp := big.NewInt(int64(n))
+		root := toBig(r)
+		next := new(big.Int).Add(root, big.NewInt(1))
+		if new(big.Int).Exp(root, exp, nil).Cmp(toBig(u)) > 0 {
+			t.Errorf("Root(%s, %d) = %s is too large", u.Hex(), n, r.Hex())
+		}
+		if new(big.Int).Exp(next, exp, nil).Cmp(toBig(u)) <= 0 {
+			t.Errorf("Root(%s, %d) = %s is too small", u.Hex(), n, r.Hex())
+		}
+	}
+
+	if _, err := New(8).Root(0); err == nil {
+		t.Error("Root(8, 0): expected error")
+	}
+	if r, _ := MAX.Root(1); !r.Equal(MAX) {
+		t.Errorf("Root(MAX, 1) = %s, want MAX", r.Hex())
+	}
+	if r, _ := MAX.Root(2); !r.Equal(MAX.Sqrt()) {
+		t.Errorf("Root(MAX, 2) = %s, want %s", r.Hex(), MAX.Sqrt().Hex())
+	}
+	// The root of 2^1024 - 1 is just below 2 for every n >= 1024
+	if r, _ := MAX.Root(5000); !r.Equal(ONE) {
+		t.Errorf("Root(MAX, 5000) = %s, want 1", r.Hex())
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []uint{2, 3, 5, 7, 64, 1023} {
+		check(ZERO, n)
+		check(ONE, n)
+		check(MAX, n)
+
+		// Exact powers, their neighbours and random values of every size
+		for _, root := range []*Uint1024{New(2), New(3), New(12345), MAX.Shr(1024 - 1024/n)} {
+			exact := new(big.Int).Exp(toBig(root), big.NewInt(int64(n)), nil)
+			if exact.BitLen() > 1024 {
+				continue
+			}
+			power := fromBig(exact)
+			if got, _ := power.Root(n); !got.Equal(root) {
+				t.Errorf("Root(%s^%d) = %s, want %s", root.Hex(), n, got.Hex(), root.Hex())
+			}
+			check(power, n)
+			check(power.Sub(ONE), n)
+			check(power.Add(ONE), n)
+		}
+		for i := 0; i < 20; i++ {
+			check(randUint1024(r).Shr(uint(r.Intn(1024))), n)
+		}
+	}
+}
+
+// TestPow tests wrapping exponentiation against math/big
+func TestPow(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+
+	tests := []struct {
+		base *Uint1024
+		exp  uint
+	}{
+		{ZERO, 0},
+		{ZERO, 5},
+		{ONE, 1000},
+		{New(2), 10},
+		{New(2), 1023},
+		{New(2), 1024}, // Wraps to zero
+		{New(3), 1000},
+		{MAX, 2},
+		{MAX, 3},
+	}
+
+	for _, tt := range tests {
+		result := tt.base.Pow(tt.exp)
+		want := new(big.Int).Exp(toBig(tt.base), new(big.Int).SetUint64(uint64(tt.exp)), modulus)
+		if toBig(result).Cmp(want) != 0 {
+			t.Errorf("%s^%d = %s, want %s", tt.base.Hex(), tt.exp, result.Hex(), want.Text(16))
+		}
+	}
+
+	if !New(10).Pow(3).Equal(New(1000)) {
+		t.Errorf("10^3: got %s, want 1000", New(10).Pow(3).String())
+	}
+}
+
+// TestPowMod tests modular exponentiation against math/big
+func TestPowMod(t *testing.T) {
+	check := func(base *Uint1024, exp uint, mod *Uint1024) {
+		t.Helper()
+		result, err := base.PowMod(exp, mod)
+		if err != nil {
+			t.Fatalf("PowMod failed: %v", err)
+		}
+		want := new(big.Int).Exp(toBig(base), new(big.Int).SetUint64(uint64(exp)), toBig(mod))
+		if toBig(result).Cmp(want) != 0 {
+			t.Errorf("%s^%d mod %s = %s, want %s", base.Hex(), exp, mod.Hex(), result.Hex(), want.Text(16))
+		}
+	}
+
+	check(New(2), 10, New(1000))
+	check(New(5), 0, New(7))
+	check(New(5), 0, ONE) // Everything is zero modulo one
+	check(MAX, 65537, MAX.Sub(ONE))
+	check(MAX, 3, ONE.Shl(1023).Add(ONE)) // Modulus with the top bit set
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5; i++ {
+		mod := randUint1024(r)
+		if mod.IsZero() {
+			continue
+		}
+		check(randUint1024(r), uint(r.Intn(1000)), mod)
+	}
+
+	if _, err := New(2).PowMod(3, ZERO); err == nil {
+		t.Error("PowMod with zero modulus should return error")
+	}
+}
+
+// TestModExp tests modular exponentiation by a full-width exponent against math/big
+func TestModExp(t *testing.T) {
+	check := func(base, exp, mod *Uint1024) {
+		t.Helper()
+		result, err := base.ModExp(exp, mod)
+		if err != nil {
+			t.Fatalf("ModExp failed: %v", err)
+		}
+		want := new(big.Int).Exp(toBig(base), toBig(exp), toBig(mod))
+		if toBig(result).Cmp(want) != 0 {
+			t.Errorf("%s^%s mod %s = %s, want %s", base.Hex(), exp.Hex(), mod.Hex(), result.Hex(), want.Text(16))
+		}
+	}
+
+	// Exponent zero gives one, modulus one gives zero
+	check(New(5), ZERO, New(7))
+	check(ZERO, ZERO, New(7))
+	check(New(5), ZERO, ONE)
+	check(MAX, MAX, ONE)
+	check(ZERO, MAX, MAX)
+	check(MAX, MAX, MAX.Sub(ONE))
+
+	if _, err := New(2).ModExp(New(3), ZERO); err == nil {
+		t.Error("ModExp with zero modulus should return error")
+	}
+
+	// Fermat's little theorem in the Oakley group: g^(p-1) = 1
+	p := fromBig(oakleyPrime())
+	if result, err := New(2).ModExp(p.Sub(ONE), p); err != nil || !result.Equal(ONE) {
+		t.Errorf("2^(p-1) mod p = (%v, %v), want 1", result, err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		mod := randUint1024(r)
+		mod.SetBit(1023) // Full 1024-bit modulus
+		check(randUint1024(r), randUint1024(r), mod)
+		check(randUint1024(r), randUint1024(r).Shr(uint(r.Intn(1024))), mod.Shr(uint(r.Intn(1023))))
+	}
+}
+
+// TestPowModDiffieHellman tests PowMod in the 1024-bit MODP group from RFC 2409 (Oakley Group 2)
+func TestPowModDiffieHellman(t *testing.T) {
+	prime := oakleyPrime()
+	p := fromBig(prime)
+	g := New(2)
+
+	const a, b = 0xDEADBEEF, 0xC0FFEE
+	publicA, err := g.PowMod(a, p)
+	if err != nil {
+		t.Fatalf("PowMod failed: %v", err)
+	}
+	publicB, err := g.PowMod(b, p)
+	if err != nil {
+		t.Fatalf("PowMod failed: %v", err)
+	}
+
+	// Both parties must derive the same shared secret
+	secretA, _ := publicB.PowMod(a, p)
+	secretB, _ := publicA.PowMod(b, p)
+	if !secretA.Equal(secretB) {
+		t.Errorf("shared secrets differ: %s vs %s", secretA.Hex(), secretB.Hex())
+	}
+
+	want := new(big.Int).Exp(big.NewInt(2), big.NewInt(a*b), prime)
+	if toBig(secretA).Cmp(want) != 0 {
+		t.Errorf("shared secret = %s, want %s", secretA.Hex(), want.Text(16))
+	}
+
+	// Without a modulus the same power simply wraps mod 2^1024
+	if !g.Pow(1024).IsZero() || !g.Pow(1023).Equal(ONE.Shl(1023)) {
+		t.Error("Pow should wrap modulo 2^1024")
+	}
+}
+
+// TestSquare tests that squaring matches the full-width multiplication
+func TestSquare(t *testing.T) {
+	check := func(u *Uint1024) {
+		t.Helper()
+		hi, lo := u.MulFull(u)
+		result, truncated := u.Square()
+		if !result.Equal(lo) {
+			t.Errorf("Square(%s) = %s, want %s", u.Hex(), result.Hex(), lo.Hex())
+		}
+		if truncated != !hi.IsZero() {
+			t.Errorf("Square(%s) truncated = %t, want %t", u.Hex(), truncated, !hi.IsZero())
+		}
+	}
+
+	// 2^511 squared is 2^1022, which fits; 2^512 squared does not
+	if result, truncated := ONE.Shl(511).Square(); truncated || !result.Equal(ONE.Shl(1022)) {
+		t.Errorf("Square(2^511) = (%s, %t), want (2^1022, false)", result.Hex(), truncated)
+	}
+	if result, truncated := ONE.Shl(512).Square(); !truncated || !result.IsZero() {
+		t.Errorf("Square(2^512) = (%s, %t), want (0, true)", result.Hex(), truncated)
+	}
+
+	check(ZERO)
+	check(ONE)
+	check(MAX.Shr(512))
+	check(MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r))
+	}
+}
+
+// TestDivMod tests combined division and modulo against math/big
+func TestDivMod(t *testing.T) {
+	check := func(a, b *Uint1024) {
+		t.Helper()
+		q, r, err := a.DivMod(b)
+		if err != nil {
+			t.Fatalf("DivMod failed: %v", err)
+		}
+		wantQ, wantR := new(big.Int).QuoRem(toBig(a), toBig(b), new(big.Int))
+		if toBig(q).Cmp(wantQ) != 0 || toBig(r).Cmp(wantR) != 0 {
+			t.Errorf("DivMod(%s, %s) = (%s, %s), want (%s, %s)", a.Hex(), b.Hex(), q.Hex(), r.Hex(), wantQ.Text(16), wantR.Text(16))
+		}
+	}
+
+	check(New(100), New(7))
+	check(New(5), New(7))
+	check(New(7), New(7))
+	check(MAX, ONE)
+	check(MAX, MAX.Sub(ONE))
+
+	// Divisors with the top bit set exercise the carry out of the shifted remainder
+	check(MAX, ONE.Shl(1023).Add(ONE))
+	check(MAX.Sub(ONE), MAX.Shr(1).Add(New(2)))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		divisor := randUint1024(r)
+		if divisor.IsZero() {
+			continue
+		}
+		check(randUint1024(r), divisor)
+	}
+
+	if _, _, err := ONE.DivMod(ZERO); err == nil {
+		t.Error("DivMod by zero should return error")
+	}
+}
+
+// TestDivModReconstruction tests that q*b + r == a and r < b for random operands,
+// using the full-width product so the check itself cannot wrap
+func TestDivModReconstruction(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 200; i++ {
+		a, b := randUint1024(r), randUint1024(r)
+		if b.IsZero() {
+			continue
+		}
+
+		q, rem, err := a.DivMod(b)
+		if err != nil {
+			t.Fatalf("DivMod failed: %v", err)
+		}
+		if !rem.Less(b) {
+			t.Errorf("DivMod(%s, %s): remainder %s is not below the divisor", a.Hex(), b.Hex(), rem.Hex())
+		}
+
+		hi, lo := q.MulFull(b)
+		sum := lo.Add(rem)
+		if !hi.IsZero() || sum.Less(lo) || !sum.Equal(a) {
+			t.Errorf("DivMod(%s, %s): q*b + r != a", a.Hex(), b.Hex())
+		}
+
+		// Div and Mod must agree with DivMod
+		divQ, _ := a.Div(b)
+		modR, _ := a.Mod(b)
+		if !divQ.Equal(q) || !modR.Equal(rem) {
+			t.Errorf("Div/Mod disagree with DivMod for %s, %s", a.Hex(), b.Hex())
+		}
+	}
+
+	if q, rem, err := New(7).DivMod(ZERO); err == nil || q != nil || rem != nil {
+		t.Error("DivMod by zero should return nil results and an error")
+	}
+}
+
+// TestStringLarge tests decimal conversion of multi-word values against math/big
+func TestStringLarge(t *testing.T) {
+	tests := []*Uint1024{New(999999999), New(1000000000), New(1000000000000000000), MAX}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		tests = append(tests, randUint1024(r))
+	}
+
+	for _, u := range tests {
+		if got, want := u.String(), toBig(u).String(); got != want {
+			t.Errorf("String() = %s, want %s", got, want)
+		}
+	}
+}
+
+// TestAddSmall tests single-word addition against math/big
+func TestAddSmall(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+	check := func(u *Uint1024, val uint64) {
+		t.Helper()
+		want := new(big.Int).Add(toBig(u), new(big.Int).SetUint64(val))
+		want.Mod(want, modulus)
+		got := u.AddSmall(val)
+		if toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s + %d = %s, want %s", u.Hex(), val, got.Hex(), want.Text(16))
+		}
+
+		inPlace := u.Clone()
+		inPlace.AddSmallInPlace(val)
+		if !inPlace.Equal(got) {
+			t.Errorf("AddSmallInPlace(%d) = %s, want %s", val, inPlace.Hex(), got.Hex())
+		}
+	}
+
+	check(ZERO, 0)
+	check(ZERO, ^uint64(0))
+	check(New(^uint64(0)), 1)               // Carries into the second word
+	check(ONE.Shl(64).Sub(ONE).Shl(960), 1) // No carry despite high bits set
+	check(MAX, 1)                           // Wraps to zero
+	check(MAX, ^uint64(0))                  // Wraps with a full-word carry
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r), r.Uint64())
+	}
+}
+
+// TestSubSmall tests single-word subtraction against math/big
+func TestSubSmall(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+	check := func(u *Uint1024, val uint64) {
+		t.Helper()
+		want := new(big.Int).Sub(toBig(u), new(big.Int).SetUint64(val))
+		want.Mod(want, modulus)
+		got := u.SubSmall(val)
+		if toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s - %d = %s, want %s", u.Hex(), val, got.Hex(), want.Text(16))
+		}
+
+		inPlace := u.Clone()
+		inPlace.SubSmallInPlace(val)
+		if !inPlace.Equal(got) {
+			t.Errorf("SubSmallInPlace(%d) = %s, want %s", val, inPlace.Hex(), got.Hex())
+		}
+	}
+
+	check(ZERO, 0)
+	check(ZERO, 1)          // Wraps to MAX
+	check(ZERO, ^uint64(0)) // Wraps with a full-word borrow
+	check(ONE.Shl(64), 1)   // Borrows from the second word
+	check(ONE.Shl(960), 1)  // Borrows through every lower word
+	check(MAX, ^uint64(0))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r), r.Uint64())
+	}
+}
+
+// TestMulSmall tests single-word multiplication against math/big
+func TestMulSmall(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+	check := func(u *Uint1024, val uint64) {
+		t.Helper()
+		want := new(big.Int).Mul(toBig(u), new(big.Int).SetUint64(val))
+		want.Mod(want, modulus)
+		if got := u.MulSmall(val); toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s * %d = %s, want %s", u.Hex(), val, got.Hex(), want.Text(16))
+		}
+	}
+
+	check(New(12345), 0)
+	check(New(12345), 1)
+	check(New(12345), 10)
+	check(MAX, 2)            // Wraps
+	check(MAX, ^uint64(0))   // Wraps with maximal carries
+	check(ONE.Shl(960), 256) // Carries out of the top word
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r), r.Uint64())
+	}
+}
+
+// TestDivUint64 tests single-word division against Div and Mod
+func TestDivUint64(t *testing.T) {
+	check := func(u *Uint1024, d uint64) {
+		t.Helper()
+		q, r, err := u.DivUint64(d)
+		if err != nil {
+			t.Fatalf("%s / %d: unexpected error %v", u, d, err)
+		}
+		m, err := u.ModUint64(d)
+		if err != nil {
+			t.Fatalf("%s %% %d: unexpected error %v", u, d, err)
+		}
+
+		wantQ, _ := u.Div(New(d))
+		wantR, _ := u.Mod(New(d))
+		if !q.Equal(wantQ) || r != wantR.Uint64() || m != wantR.Uint64() {
+			t.Errorf("%s / %d = (%s, %d), mod %d, want (%s, %s)", u, d, q, r, m, wantQ, wantR)
+		}
+	}
+
+	for _, d := range []uint64{1, 2, 3, 10, 1000, 1<<32 - 1, 1 << 32, 1 << 63, ^uint64(0)} {
+		check(ZERO, d)
+		check(New(d-1), d)
+		check(MAX, d)
+		check(ONE.Shl(1023), d)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r), r.Uint64()>>uint(r.Intn(64))|1)
+	}
+
+	if _, _, err := MAX.DivUint64(0); err == nil {
+		t.Error("DivUint64(0): expected division by zero error")
+	}
+	if _, err := MAX.ModUint64(0); err == nil {
+		t.Error("ModUint64(0): expected division by zero error")
+	}
+}
+
+// TestDivSmall tests single-word division against math/big
+func TestDivSmall(t *testing.T) {
+	check := func(u *Uint1024, divisor uint64) {
+		t.Helper()
+		before := u.Clone()
+		q, rem := u.DivSmall(divisor)
+		if !u.Equal(before) {
+			t.Fatalf("DivSmall modified the receiver: %s, was %s", u, before)
+		}
+
+		wantQ, wantR := new(big.Int).QuoRem(toBig(u), new(big.Int).SetUint64(divisor), new(big.Int))
+		if toBig(q).Cmp(wantQ) != 0 || rem != wantR.Uint64() {
+			t.Errorf("%s / %d = (%s, %d), want (%s, %s)", u, divisor, q, rem, wantQ, wantR)
+		}
+	}
+
+	for _, divisor := range []uint64{1, 3, 10, 1000000000, 1 << 32, 1<<32 + 1, ^uint64(0)} {
+		check(ZERO, divisor)
+		check(MAX, divisor)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r), r.Uint64()>>uint(r.Intn(64))|1)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("DivSmall(0): expected panic")
+		}
+	}()
+	MAX.DivSmall(0)
+}
+
+// TestAddInt64 tests signed single-word addition against math/big
+func TestAddInt64(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+	check := func(u *Uint1024, delta int64) {
+		t.Helper()
+		want := new(big.Int).Add(toBig(u), big.NewInt(delta))
+		got, err := u.AddInt64(delta)
+
+		if want.Sign() < 0 {
+			if err == nil {
+				t.Errorf("%s + %d: expected underflow error, got %s", u, delta, got)
+			}
+			inPlace := u.Clone()
+			if err := inPlace.AddInt64InPlace(delta); err == nil || !inPlace.Equal(u) {
+				t.Errorf("%s + %d in place: expected error and unchanged value, got %v and %s", u, delta, err, inPlace)
+			}
+			return
+		}
+
+		want.Mod(want, modulus)
+		if err != nil {
+			t.Fatalf("%s + %d: unexpected error %v", u, delta, err)
+		}
+		if toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s + %d = %s, want %s", u, delta, got, want)
+		}
+		inPlace := u.Clone()
+		if err := inPlace.AddInt64InPlace(delta); err != nil || !inPlace.Equal(got) {
+			t.Errorf("%s + %d in place = %s (%v), want %s", u, delta, inPlace, err, got)
+		}
+	}
+
+	deltas := []int64{0, 1, -1, math.MaxInt64, math.MinInt64, math.MinInt64 + 1}
+	values := []*Uint1024{ZERO, ONE, New(1 << 63), New(1<<63 - 1), ONE.Shl(64), MAX}
+	for _, u := range values {
+		for _, delta := range deltas {
+			check(u, delta)
+		}
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r), int64(r.Uint64()))
+		check(New(r.Uint64()>>1), int64(r.Uint64()))
+	}
+}
+
+// TestModSmall tests single-word modulo against math/big
+func TestModSmall(t *testing.T) {
+	check := func(u *Uint1024, divisor uint64) {
+		t.Helper()
+		want := new(big.Int).Mod(toBig(u), new(big.Int).SetUint64(divisor))
+		if got := u.ModSmall(divisor); got != want.Uint64() {
+			t.Errorf("%s %% %d = %d, want %s", u, divisor, got, want)
+		}
+	}
+
+	for _, divisor := range []uint64{1, 2, 7, 10, 1000000007, 1 << 32, ^uint64(0)} {
+		check(ZERO, divisor)
+		check(MAX, divisor)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r), r.Uint64()>>uint(r.Intn(64))|1)
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { MAX.ModSmall(10) }); allocs != 0 {
+		t.Errorf("ModSmall allocated %v times, want 0", allocs)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("ModSmall(0): expected panic")
+		}
+	}()
+	MAX.ModSmall(0)
+}
+
+// TestModSmallTrialDivision tests divisibility checks by small primes on 1024-bit values
+func TestModSmallTrialDivision(t *testing.T) {
+	primes := []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		// A random odd candidate, multiplied by a known small prime every other round
+		u := randUint1024(r)
+		u.words[15] >>= 8
+		u.words[0] |= 1
+		if i%2 == 0 {
+			u = u.MulSmall(primes[r.Intn(len(primes))])
+		}
+
+		for _, p := range primes {
+			divisible := u.ModSmall(p) == 0
+			want := new(big.Int).Mod(toBig(u), new(big.Int).SetUint64(p)).Sign() == 0
+			if divisible != want {
+				t.Errorf("%s divisible by %d: got %v, want %v", u, p, divisible, want)
+			}
+			if q, rem := u.DivSmall(p); divisible && (rem != 0 || !q.MulSmall(p).Equal(u)) {
+				t.Errorf("%s / %d = (%s, %d) does not reconstruct the dividend", u, p, q, rem)
+			}
+		}
+	}
+}
+
+// TestCheckedArithmetic tests that checked operations match math/big or fail with the sentinel errors
+func TestCheckedArithmetic(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+	ops := []struct {
+		name    string
+		checked func(a, b *Uint1024) (*Uint1024, error)
+		exact   func(z, x, y *big.Int) *big.Int
+	}{
+		{"CheckedAdd", (*Uint1024).CheckedAdd, (*big.Int).Add},
+		{"CheckedSub", (*Uint1024).CheckedSub, (*big.Int).Sub},
+		{"CheckedMul", (*Uint1024).CheckedMul, (*big.Int).Mul},
+	}
+
+	values := []*Uint1024{ZERO, ONE, New(2), MAX, MAX.Sub(ONE), ONE.Shl(512), ONE.Shl(511), ONE.Shl(1023)}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		values = append(values, randUint1024(r))
+	}
+
+	for _, op := range ops {
+		t.Run(op.name, func(t *testing.T) {
+			for _, a := range values {
+				for _, b := range values {
+					want := op.exact(new(big.Int), toBig(a), toBig(b))
+					got, err := op.checked(a, b)
+
+					switch {
+					case want.Sign() < 0:
+						if !errors.Is(err, ErrUnderflow) {
+							t.Errorf("%s(%s, %s): got (%v, %v), want ErrUnderflow", op.name, a, b, got, err)
+						}
+					case want.Cmp(modulus) >= 0:
+						if !errors.Is(err, ErrOverflow) {
+							t.Errorf("%s(%s, %s): got (%v, %v), want ErrOverflow", op.name, a, b, got, err)
+						}
+					case err != nil || toBig(got).Cmp(want) != 0:
+						t.Errorf("%s(%s, %s) = (%v, %v), want %s", op.name, a, b, got, err, want)
+					}
+				}
+			}
+		})
+	}
+
+	if got, err := MAX.CheckedAdd(ONE); got != nil || !errors.Is(err, ErrOverflow) {
+		t.Errorf("MAX + 1 = (%v, %v), want (nil, ErrOverflow)", got, err)
+	}
+	if got, err := MAX.CheckedAdd(ZERO); err != nil || !got.Equal(MAX) {
+		t.Errorf("MAX + 0 = (%v, %v), want (MAX, nil)", got, err)
+	}
+	half := ONE.Shl(1022)
+	if got, err := half.CheckedAdd(half); err != nil || !got.Equal(ONE.Shl(1023)) {
+		t.Errorf("2^1022 + 2^1022 = (%v, %v), want (2^1023, nil)", got, err)
+	}
+
+	if _, err := ZERO.AddInt64(-1); !errors.Is(err, ErrUnderflow) {
+		t.Errorf("AddInt64 below zero: got %v, want ErrUnderflow", err)
+	}
+}
+
+// TestDecimalAccumulation tests building a value digit by digit with MulSmall and AddSmallInPlace
+func TestDecimalAccumulation(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	digits := make([]byte, 300)
+	for i := range digits {
+		digits[i] = '0' + byte(r.Intn(10))
+	}
+
+	acc := ZERO.Clone()
+	for _, d := range digits {
+		acc = acc.MulSmall(10)
+		acc.AddSmallInPlace(uint64(d - '0'))
+	}
+
+	want, _ := new(big.Int).SetString(string(digits), 10)
+	if toBig(acc).Cmp(want) != 0 {
+		t.Errorf("accumulated %s, want %s", acc, want)
+	}
+
+	// Undo the last digit with a small correction
+	acc.SubSmallInPlace(uint64(digits[len(digits)-1] - '0'))
+	if q, rem := acc.DivSmall(10); rem != 0 || toBig(q).Cmp(new(big.Int).Quo(want, big.NewInt(10))) != 0 {
+		t.Errorf("after removing the last digit: (%s, %d), want (%s, 0)", q, rem, new(big.Int).Quo(want, big.NewInt(10)))
+	}
+}
+
+// TestAddMod tests modular addition of reduced operands against math/big
+func TestAddMod(t *testing.T) {
+	check := func(a, b, mod *Uint1024) {
+		t.Helper()
+		want := new(big.Int).Add(toBig(a), toBig(b))
+		want.Mod(want, toBig(mod))
+		if got := a.AddMod(b, mod); toBig(got).Cmp(want) != 0 {
+			t.Errorf("(%s + %s) %% %s = %s, want %s", a, b, mod, got, want)
+		}
+	}
+
+	check(ZERO, ZERO, ONE)
+	check(New(5), New(6), New(7))
+	check(New(3), New(3), New(7))
+	check(MAX.Sub(ONE), MAX.Sub(ONE), MAX) // The sum carries out of the top word
+	check(MAX.Sub(ONE), ONE, MAX)          // The sum equals mod exactly
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		mod := randUint1024(r)
+		if mod.IsZero() {
+			continue
+		}
+		a, _ := randUint1024(r).Mod(mod)
+		b, _ := randUint1024(r).Mod(mod)
+		check(a, b, mod)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("AddMod with zero modulus: expected panic")
+		}
+	}()
+	ONE.AddMod(ONE, ZERO)
+}
+
+// TestMulChecked tests truncation reporting against math/big, especially near the boundary
+func TestMulChecked(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+	check := func(a, b *Uint1024) {
+		t.Helper()
+		product := new(big.Int).Mul(toBig(a), toBig(b))
+		wantOverflow := product.Cmp(modulus) >= 0
+
+		got, overflow := a.MulChecked(b)
+		if overflow != wantOverflow || !got.Equal(a.Mul(b)) {
+			t.Errorf("MulChecked(%s, %s) = (%s, %v), want (%s, %v)", a, b, got, overflow, a.Mul(b), wantOverflow)
+		}
+		if a.MulOverflows(b) != wantOverflow {
+			t.Errorf("MulOverflows(%s, %s) = %v, want %v", a, b, !wantOverflow, wantOverflow)
+		}
+	}
+
+	check(ZERO, MAX)
+	check(MAX, ONE)
+	check(MAX, New(2))
+	check(ONE.Shl(1023), New(2))
+	check(ONE.Shl(512), ONE.Shl(511)) // Bit lengths sum to 1025, product fits
+	check(ONE.Shl(512), ONE.Shl(512)) // Bit lengths sum to 1026
+	check(MAX.Shr(512), MAX.Shr(511)) // Bit lengths sum to 1025, product fits
+	check(MAX.Shr(511), MAX.Shr(511)) // Bit lengths sum to 1026
+
+	// Bit lengths summing to exactly 1025 need the exact product either way
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		la := 1 + r.Intn(1024)
+		a := FromLimbs(randWords(r, 16)).Shr(uint(1024 - la))
+		a.SetBit(la - 1)
+		b := FromLimbs(randWords(r, 16)).Shr(uint(la - 1))
+		b.SetBit(1025 - la - 1)
+		check(a, b)
+		check(randUint1024(r), randUint1024(r))
+	}
+}
+
+// TestMulCheckedFullProduct checks the truncated product and flag against the full
+// 2048-bit schoolbook product and math/big
+func TestMulCheckedFullProduct(t *testing.T) {
+	check := func(a, b *Uint1024) {
+		t.Helper()
+		product := new(big.Int).Mul(toBig(a), toBig(b))
+		full := a.mulFull(b)
+
+		got, overflow := a.MulChecked(b)
+		if limbsToBig(full[:]).Cmp(product) != 0 {
+			t.Fatalf("mulFull(%s, %s) = %x, want %x", a, b, limbsToBig(full[:]), product)
+		}
+		if want := !isZeroWords(full[16:]); overflow != want || !got.Equal(FromLimbs(full[:16])) {
+			t.Errorf("MulChecked(%s, %s) = (%s, %v), want (%s, %v)", a, b, got, overflow, FromLimbs(full[:16]), want)
+		}
+	}
+
+	check(ZERO, ZERO)
+	check(MAX, ZERO)
+	check(MAX, ONE)
+	check(MAX, MAX)                   // The high half is 2^1024 - 2
+	check(ONE.Shl(1023), New(2))      // Exactly 2^1024, with all low bits lost
+	check(ONE.Shl(512), ONE.Shl(511)) // The largest power of two that fits
+	check(MAX.Shr(512), MAX.Shr(512).Add(New(2)))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r), randUint1024(r))
+		check(FromLimbs(randWords(r, r.Intn(16)+1)), FromLimbs(randWords(r, r.Intn(16)+1)))
+	}
+}
+
+// TestSubMod tests modular subtraction of reduced operands against math/big
+func TestSubMod(t *testing.T) {
+	check := func(a, b, mod *Uint1024) {
+		t.Helper()
+		want := new(big.Int).Sub(toBig(a), toBig(b))
+		want.Mod(want, toBig(mod))
+		if got := a.SubMod(b, mod); toBig(got).Cmp(want) != 0 {
+			t.Errorf("(%s - %s) %% %s = %s, want %s", a, b, mod, got, want)
+		}
+	}
+
+	check(ZERO, ZERO, ONE)
+	check(New(6), New(5), New(7))
+	check(New(5), New(6), New(7)) // Wraps below zero
+	check(ZERO, MAX.Sub(ONE), MAX)
+	check(MAX.Sub(ONE), ZERO, MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		mod := randUint1024(r)
+		if mod.IsZero() {
+			continue
+		}
+		a, _ := randUint1024(r).Mod(mod)
+		b, _ := randUint1024(r).Mod(mod)
+		check(a, b, mod)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SubMod with zero modulus: expected panic")
+		}
+	}()
+	ONE.SubMod(ONE, ZERO)
+}
+
+// TestSqr tests that Sqr and SqrFull match Mul and MulFull bit for bit
+func TestSqr(t *testing.T) {
+	check := func(u *Uint1024) {
+		t.Helper()
+		if got, want := u.Sqr(), u.Mul(u); !got.Equal(want) {
+			t.Errorf("Sqr(%s) = %s, want %s", u, got, want)
+		}
+		hi, lo := u.SqrFull()
+		wantHi, wantLo := u.MulFull(u)
+		if !hi.Equal(wantHi) || !lo.Equal(wantLo) {
+			t.Errorf("SqrFull(%s) = (%s, %s), want (%s, %s)", u, hi, lo, wantHi, wantLo)
+		}
+	}
+
+	check(ZERO)
+	check(ONE)
+	check(MAX)
+	check(ONE.Shl(512))
+	check(ONE.Shl(1023))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint1024(r))
+	}
+}
+
+// TestMulMod tests modular multiplication against math/big
+func TestMulMod(t *testing.T) {
+	check := func(a, b, mod *Uint1024) {
+		t.Helper()
+		want := new(big.Int).Mul(toBig(a), toBig(b))
+		want.Mod(want, toBig(mod))
+		got, err := a.MulMod(b, mod)
+		if err != nil {
+			t.Fatalf("(%s * %s) %% %s: unexpected error %v", a, b, mod, err)
+		}
+		if toBig(got).Cmp(want) != 0 {
+			t.Errorf("(%s * %s) %% %s = %s, want %s", a, b, mod, got, want)
+		}
+	}
+
+	check(MAX, MAX, ONE)
+	check(MAX, MAX, MAX)
+	check(MAX, MAX, MAX.Sub(ONE))
+	check(MAX, MAX, New(1000000007))
+	check(ONE.Shl(1023), New(2), ONE.Shl(1023).Add(ONE))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		mod := randUint1024(r)
+		if mod.IsZero() {
+			continue
+		}
+		check(randUint1024(r), randUint1024(r), mod)
+		check(randUint1024(r), randUint1024(r), mod.Shr(uint(r.Intn(1024))).Add(ONE))
+	}
+
+	// In the Oakley prime field (p-1)^2 exceeds 1024 bits before reduction
+	// and reduces to 1 mod p
+	p := fromBig(oakleyPrime())
+	pMinus1 := p.Sub(ONE)
+	check(pMinus1, pMinus1, p)
+	if got, _ := pMinus1.MulMod(pMinus1, p); !got.Equal(ONE) {
+		t.Errorf("(p-1)^2 mod p = %s, want 1", got)
+	}
+	if got, _ := MAX.MulMod(New(12345), ONE); !got.IsZero() {
+		t.Errorf("MulMod modulo one = %s, want 0", got)
+	}
+
+	if _, err := ONE.MulMod(ONE, ZERO); err == nil {
+		t.Error("MulMod with zero modulus: expected error")
+	}
+}
+
+// TestExtendedGCD verifies the Bézout identity with full-width products and the
+// bound on the coefficients
+func TestExtendedGCD(t *testing.T) {
+	check := func(a, b *Uint1024) {
+		t.Helper()
+		g, x, y, xNeg, yNeg := a.ExtendedGCD(b)
+
+		if want := new(big.Int).GCD(nil, nil, toBig(a), toBig(b)); toBig(g).Cmp(want) != 0 {
+			t.Errorf("ExtendedGCD(%s, %s): g = %s, want %s", a, b, g, want)
+			return
+		}
+		if xNeg && yNeg {
+			t.Errorf("ExtendedGCD(%s, %s): both coefficients negative", a, b)
+			return
+		}
+
+		// Combine the exact 2048-bit products according to the signs
+		ax, by := a.mulFull(x), b.mulFull(y)
+		var combined [32]uint64
+		var lost uint64
+		switch {
+		case xNeg:
+			combined = by
+			lost = subWords(combined[:], ax[:])
+		case yNeg:
+			combined = ax
+			lost = subWords(combined[:], by[:])
+		default:
+			combined = ax
+			lost = addWords(combined[:], by[:])
+		}
+		if lost != 0 || cmpWords(combined[:], g.words[:]) != 0 {
+			t.Errorf("ExtendedGCD(%s, %s) = (%s, %s, %s, %v, %v) does not satisfy the Bézout identity", a, b, g, x, y, xNeg, yNeg)
+		}
+
+		// |x| <= max(1, b/(2g)) and |y| <= max(1, a/(2g))
+		if !g.IsZero() {
+			xBound, yBound := toBig(b), toBig(a)
+			twoG := new(big.Int).Lsh(toBig(g), 1)
+			xBound.Quo(xBound, twoG)
+			yBound.Quo(yBound, twoG)
+			if xBound.Sign() == 0 {
+				xBound.SetInt64(1)
+			}
+			if yBound.Sign() == 0 {
+				yBound.SetInt64(1)
+			}
+			if toBig(x).Cmp(xBound) > 0 || toBig(y).Cmp(yBound) > 0 {
+				t.Errorf("ExtendedGCD(%s, %s): coefficients (%s, %s) exceed bounds (%s, %s)", a, b, x, y, xBound, yBound)
+			}
+		}
+	}
+
+	check(ZERO, ZERO)
+	check(ZERO, New(5))
+	check(New(5), ZERO)
+	check(New(240), New(46))
+	check(New(46), New(240))
+	check(MAX, MAX)
+	check(MAX, MAX.Sub(ONE))
+	check(MAX, ONE.Shl(1023))
+	check(New(7), New(7))
+	check(ONE, MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a, b := randUint1024(r), randUint1024(r).Shr(uint(r.Intn(1024)))
+		check(a, b)
+		check(b, a)
+
+		// A large common factor
+		c := randUint1024(r).Shr(uint(r.Intn(1024)))
+		shift := uint(1024 - c.LeadingZeros())
+		check(c.Mul(a.Shr(shift)), c.Mul(b.Shr(shift)))
+	}
+}
+
+// TestModInverse tests modular inverses against math/big for odd and even moduli
+func TestModInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		mod := randUint1024(r).Shr(uint(r.Intn(1024)))
+		if i%2 == 0 {
+			mod.ClearBit(0) // Even modulus
+		}
+		a := randUint1024(r)
+		if i%4 == 1 {
+			a.ShrInPlace(uint(r.Intn(1024))) // Operands much smaller than the modulus
+		}
+
+		want := new(big.Int).ModInverse(toBig(a), toBig(mod))
+		got, err := a.ModInverse(mod)
+		if want == nil || mod.LessOrEqual(ONE) {
+			if !errors.Is(err, ErrNotInvertible) {
+				t.Errorf("ModInverse(%s, %s): got (%v, %v), want ErrNotInvertible", a, mod, got, err)
+			}
+			continue
+		}
+		if err != nil || toBig(got).Cmp(want) != 0 {
+			t.Errorf("ModInverse(%s, %s) = (%v, %v), want %s", a, mod, got, err, want)
+			continue
+		}
+		if product, _ := a.MulMod(got, mod); !product.Equal(ONE) {
+			t.Errorf("%s * %s mod %s = %s, want 1", a, got, mod, product)
+		}
+	}
+
+	for _, tc := range []struct{ a, mod *Uint1024 }{
+		{New(3), ZERO},
+		{New(3), ONE},
+		{ZERO, New(7)},
+		{New(6), New(9)},
+		{New(4), New(10)},
+		{New(15), New(10)},
+		{MAX.Sub(ONE), ONE.Shl(1023)},
+	} {
+		if _, err := tc.a.ModInverse(tc.mod); !errors.Is(err, ErrNotInvertible) {
+			t.Errorf("ModInverse(%s, %s): got %v, want ErrNotInvertible", tc.a, tc.mod, err)
+		}
+	}
+
+	// Full-width moduli, where halving the coefficient carries out of 1024 bits
+	for _, tc := range []struct{ a, mod *Uint1024 }{
+		{New(2), MAX},
+		{MAX.Sub(ONE), MAX},
+		{MAX, ONE.Shl(1023)},
+		{ONE, New(2)},
+		{New(3), fromBig(oakleyPrime())},
+	} {
+		got, err := tc.a.ModInverse(tc.mod)
+		if err != nil {
+			t.Errorf("ModInverse(%s, %s): unexpected error %v", tc.a, tc.mod, err)
+			continue
+		}
+		if product, _ := tc.a.MulMod(got, tc.mod); !product.Equal(ONE) {
+			t.Errorf("%s * %s mod %s = %s, want 1", tc.a, got, tc.mod, product)
+		}
+	}
+}
+
+// TestAddModField tests modular addition and subtraction in the RFC 2409 1024-bit prime field
+func TestAddModField(t *testing.T) {
+	prime := oakleyPrime()
+	p := fromBig(prime)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a, _ := FromLimbs(randWords(r, 16)).Mod(p)
+		b, _ := FromLimbs(randWords(r, 16)).Mod(p)
+
+		want := new(big.Int).Add(toBig(a), toBig(b))
+		want.Mod(want, prime)
+		sum := a.AddMod(b, p)
+		if toBig(sum).Cmp(want) != 0 {
+			t.Errorf("(%s + %s) mod p = %s, want %s", a, b, sum, want)
+		}
+		if back := sum.SubMod(b, p); !back.Equal(a) {
+			t.Errorf("(%s + %s) - %s mod p = %s, want %s", a, b, b, back, a)
+		}
+	}
+
+	// Repeated doubling, as in a ladder step, must agree with exponentiation
+	x := ONE.Clone()
+	for i := 0; i < 2048; i++ {
+		x = x.AddMod(x, p)
+	}
+	if want, _ := New(2).PowMod(2048, p); !x.Equal(want) {
+		t.Errorf("2^2048 mod p by doubling = %s, want %s", x, want)
+	}
+}
+
+// oakleyPrime returns the 1024-bit MODP prime from RFC 2409 (Oakley Group 2)
+func oakleyPrime() *big.Int {
+	prime, _ := new(big.Int).SetString(
+		"FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD1"+
+			"29024E088A67CC74020BBEA63B139B22514A08798E3404DD"+
+			"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245"+
+			"E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED"+
+			"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE65381"+
+			"FFFFFFFFFFFFFFFF", 16)
+	return prime
+}
+
+// TestExp tests exponentiation against math/big with a 2^1024 modulus
+func TestExp(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+	check := func(base, exp *Uint1024) {
+		t.Helper()
+		want := new(big.Int).Exp(toBig(base), toBig(exp), modulus)
+		if got := base.Exp(exp); toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s^%s = %s, want %s", base, exp, got, want)
+		}
+		if exp.FitsUint64() {
+			if got := base.ExpUint64(exp.Uint64()); toBig(got).Cmp(want) != 0 {
+				t.Errorf("ExpUint64: %s^%s = %s, want %s", base, exp, got, want)
+			}
+		}
+	}
+
+	// Special cases
+	if got := New(12345).Exp(ZERO); !got.Equal(ONE) {
+		t.Errorf("x^0 = %s, want 1", got)
+	}
+	if got := ZERO.Exp(New(5)); !got.IsZero() {
+		t.Errorf("0^5 = %s, want 0", got)
+	}
+	if got := ZERO.Exp(ZERO); !got.Equal(ONE) {
+		t.Errorf("0^0 = %s, want 1", got)
+	}
+	if got := ZERO.ExpUint64(0); !got.Equal(ONE) {
+		t.Errorf("ExpUint64: 0^0 = %s, want 1", got)
+	}
+
+	check(New(2), New(1023))
+	check(New(2), New(1024)) // Wraps to zero
+	check(New(3), New(1000))
+	check(MAX, New(2))
+	check(MAX, MAX) // Odd exponent of -1 mod 2^1024
+	check(New(3), MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		check(New(r.Uint64()), New(r.Uint64()%1000))
+		check(randUint1024(r), randUint1024(r))
+	}
+}
+
+// TestSaturatingArithmetic tests that saturating operations clamp instead of wrapping
+func TestSaturatingArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  *Uint1024
+		want *Uint1024
+	}{
+		{"MAX + 0", MAX.SaturatingAdd(ZERO), MAX},
+		{"MAX + 1", MAX.SaturatingAdd(ONE), MAX},
+		{"MAX-1 + 1", MAX.Sub(ONE).SaturatingAdd(ONE), MAX},
+		{"MAX + MAX", MAX.SaturatingAdd(MAX), MAX},
+		{"2^1023 + 2^1023", ONE.Shl(1023).SaturatingAdd(ONE.Shl(1023)), MAX},
+		{"2 + 3", New(2).SaturatingAdd(New(3)), New(5)},
+		{"0 - 1", ZERO.SaturatingSub(ONE), ZERO},
+		{"MAX - MAX", MAX.SaturatingSub(MAX), ZERO},
+		{"5 - 3", New(5).SaturatingSub(New(3)), New(2)},
+		{"1 - MAX", ONE.SaturatingSub(MAX), ZERO},
+		{"2^1023 - (2^1023+1)", ONE.Shl(1023).SaturatingSub(ONE.Shl(1023).Add(ONE)), ZERO},
+		{"MAX - 1", MAX.SaturatingSub(ONE), MAX.Sub(ONE)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", tt.got.Hex(), tt.want.Hex())
+			}
+		})
+	}
+
+	// Random sums straddling 2^1024 and differences straddling zero
+	limit := toBig(MAX)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a := randUint1024(r)
+		b := a.Not().Add(New(uint64(r.Intn(3)))).Sub(ONE) // MAX - a + {-1, 0, 1}
+		want := new(big.Int).Add(toBig(a), toBig(b))
+		if want.Cmp(limit) > 0 {
+			want = limit
+		}
+		if got := a.SaturatingAdd(b); toBig(got).Cmp(want) != 0 {
+			t.Errorf("SaturatingAdd(%s, %s) = %s, want %s", a, b, got, want)
+		}
+
+		c := a.Add(New(uint64(r.Intn(3)))).Sub(ONE) // a + {-1, 0, 1}
+		want = new(big.Int).Sub(toBig(a), toBig(c))
+		if want.Sign() < 0 {
+			want.SetInt64(0)
+		}
+		if got := a.SaturatingSub(c); toBig(got).Cmp(want) != 0 {
+			t.Errorf("SaturatingSub(%s, %s) = %s, want %s", a, c, got, want)
+		}
+	}
+
+	// The clamped results must not alias the global constants
+	if result := MAX.SaturatingAdd(ONE); result == MAX {
+		t.Error("SaturatingAdd returned the MAX constant itself")
+	}
+	if result := ZERO.SaturatingSub(ONE); result == ZERO {
+		t.Error("SaturatingSub returned the ZERO constant itself")
+	}
+}
+
+// toBig converts a Uint1024 to a big.Int for cross-checking results
+func toBig(u *Uint1024) *big.Int {
+	return new(big.Int).SetBytes(u.ToBeBytes())
+}
+
+// fromBig converts a big.Int below 2^1024 to a Uint1024
+func fromBig(b *big.Int) *Uint1024 {
+	return FromBeBytes(b.Bytes())
+}
+
+// BenchmarkSquare compares squaring against the general multiplication
+func BenchmarkSquare(b *testing.B) {
+	u := MAX.Sub(New(12345))
+
+	b.Run("Square", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.Square()
+		}
+	})
+	b.Run("MulFull", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.MulFull(u)
+		}
+	})
+	b.Run("Sqr", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.Sqr()
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.Mul(u)
+		}
+	})
+	b.Run("SqrFull", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.SqrFull()
+		}
+	})
+}
+
+// BenchmarkMulSmall compares single-word multiplication against the general Mul
+func BenchmarkMulSmall(b *testing.B) {
+	u := MAX.Sub(New(12345))
+
+	b.Run("MulSmall", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.MulSmall(1000000007)
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.Mul(New(1000000007))
+		}
+	})
+}
+
+// BenchmarkModSmall compares single-word modulo against the general Mod
+func BenchmarkModSmall(b *testing.B) {
+	u := MAX.Sub(New(12345))
+
+	b.Run("ModSmall", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.ModSmall(1000000007)
+		}
+	})
+	b.Run("Mod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.Mod(New(1000000007))
+		}
+	})
+}
+
+// BenchmarkMulMod compares modular multiplication against math/big
+func BenchmarkMulMod(b *testing.B) {
+	x, y := MAX.Sub(New(12345)), MAX.Sub(New(67890))
+	mod := MAX.Shr(1).Sub(New(1000))
+
+	b.Run("MulMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.MulMod(y, mod)
+		}
+	})
+	b.Run("BigInt", func(b *testing.B) {
+		bx, by, bmod := toBig(x), toBig(y), toBig(mod)
+		z := new(big.Int)
+		for i := 0; i < b.N; i++ {
+			z.Mul(bx, by)
+			z.Mod(z, bmod)
+		}
+	})
+}
+
+// BenchmarkAddMod compares modular addition against Add followed by Mod;
+// with this full-width modulus the latter is also wrong, because the sum wraps
+func BenchmarkAddMod(b *testing.B) {
+	p := fromBig(oakleyPrime())
+	x, y := p.Sub(New(12345)), p.Sub(New(67890))
+
+	b.Run("AddMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.AddMod(y, p)
+		}
+	})
+	b.Run("AddThenMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.Add(y).Mod(p)
+		}
+	})
+}
+
+// BenchmarkString measures decimal conversion of a full-width value
+func BenchmarkString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = MAX.String()
+	}
+}
+
+// randUint1024 returns a random value with a random number of significant limbs,
+// so that tests cover small operands as well as full-width ones
+func randUint1024(r *rand.Rand) *Uint1024 {
+	u := &Uint1024{}
+	n := r.Intn(len(u.words)) + 1
+	for i := 0; i < n; i++ {
+		u.words[i] = r.Uint64()
+	}
+	return u
+}
@@ -0,0 +1,61 @@
+// value.go implements value-receiver operations for Uint1024
+package uint1024
+
+// The methods in this file take and return Uint1024 by value instead of by pointer.
+// Every result is an explicit copy, so two variables never share the same
+// underlying words, and short computations can stay on the stack instead of
+// allocating a new Uint1024 per operation.
+
+// AddVal performs addition by value: result = a + b.
+func (u Uint1024) AddVal(other Uint1024) Uint1024 {
+	u.AddInPlace(&other)
+	return u
+}
+
+// SubVal performs subtraction by value: result = a - b.
+func (u Uint1024) SubVal(other Uint1024) Uint1024 {
+	u.SubInPlace(&other)
+	return u
+}
+
+// MulVal performs multiplication by value: result = a * b mod 2^1024.
+// Like Mul, the product wraps.
+func (u Uint1024) MulVal(other Uint1024) Uint1024 {
+	return u.mulLow(&other)
+}
+
+// AndVal performs bitwise AND by value: result = a & b.
+func (u Uint1024) AndVal(other Uint1024) Uint1024 {
+	u.AndInPlace(&other)
+	return u
+}
+
+// OrVal performs bitwise OR by value: result = a | b.
+func (u Uint1024) OrVal(other Uint1024) Uint1024 {
+	u.OrInPlace(&other)
+	return u
+}
+
+// XorVal performs bitwise XOR by value: result = a ^ b.
+func (u Uint1024) XorVal(other Uint1024) Uint1024 {
+	u.XorInPlace(&other)
+	return u
+}
+
+// NotVal performs bitwise NOT by value: result = ^a.
+func (u Uint1024) NotVal() Uint1024 {
+	u.NotInPlace()
+	return u
+}
+
+// ShlVal performs left shift by value: result = a << n.
+func (u Uint1024) ShlVal(n uint) Uint1024 {
+	u.ShlInPlace(n)
+	return u
+}
+
+// ShrVal performs right shift by value: result = a >> n.
+func (u Uint1024) ShrVal(n uint) Uint1024 {
+	u.ShrInPlace(n)
+	return u
+}
@@ -0,0 +1,87 @@
+package uint1024
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestValueMethods tests that the value-receiver methods match the pointer API
+func TestValueMethods(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 50; i++ {
+		a, b := randUint1024(r), randUint1024(r)
+		av, bv := *a, *b
+
+		checks := []struct {
+			name string
+			got  Uint1024
+			want *Uint1024
+		}{
+			{"AddVal", av.AddVal(bv), a.Add(b)},
+			{"SubVal", av.SubVal(bv), a.Sub(b)},
+			{"MulVal", av.MulVal(bv), a.Mul(b)},
+			{"AndVal", av.AndVal(bv), a.And(b)},
+			{"OrVal", av.OrVal(bv), a.Or(b)},
+			{"XorVal", av.XorVal(bv), a.Xor(b)},
+			{"NotVal", av.NotVal(), a.Not()},
+			{"ShlVal", av.ShlVal(77), a.Shl(77)},
+			{"ShrVal", av.ShrVal(77), a.Shr(77)},
+		}
+		for _, c := range checks {
+			if !c.got.Equal(c.want) {
+				t.Errorf("%s: got %s, want %s", c.name, c.got.Hex(), c.want.Hex())
+			}
+		}
+
+		// Operands are copies and must be left untouched
+		if !av.Equal(a) || !bv.Equal(b) {
+			t.Error("value methods should not modify their operands")
+		}
+	}
+}
+
+// TestValueCopySemantics tests that assigning a value produces an independent copy
+func TestValueCopySemantics(t *testing.T) {
+	a := *New(1)
+	b := a
+	b = b.AddVal(*ONE)
+
+	if !a.Equal(ONE) || !b.Equal(New(2)) {
+		t.Errorf("assignment should copy: a = %s, b = %s", a.String(), b.String())
+	}
+}
+
+// TestValueAllocations tests that a chain of value operations does not allocate
+func TestValueAllocations(t *testing.T) {
+	a, b, c := *New(123456789), *MAX.Shr(3), *New(987654321)
+
+	var result Uint1024
+	allocs := testing.AllocsPerRun(100, func() {
+		result = a.AddVal(b).MulVal(c).ShrVal(5)
+	})
+	if allocs != 0 {
+		t.Errorf("value chain allocated %v times per run, want 0", allocs)
+	}
+	_ = result
+}
+
+// BenchmarkChain compares pointer and value forms of ((a+b)*c)>>5
+func BenchmarkChain(b *testing.B) {
+	x, y, z := MAX.Shr(3), New(123456789), MAX.Shr(700)
+
+	b.Run("Pointer", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = x.Add(y).Mul(z).Shr(5)
+		}
+	})
+
+	b.Run("Value", func(b *testing.B) {
+		xv, yv, zv := *x, *y, *z
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = xv.AddVal(yv).MulVal(zv).ShrVal(5)
+		}
+	})
+}
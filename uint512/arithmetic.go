@@ -4,6 +4,8 @@ package uint512
 import (
 	"fmt"
 	"math/bits"
+
+	"github.com/Alivers/guint/uint1024"
 )
 
 // Add performs addition: result = a + b.
@@ -31,6 +33,33 @@ func (u *Uint512) AddInPlace(other *Uint512) {
 	}
 }
 
+// AddSmall performs addition of a single word: result = a + val mod 2^512.
+// The carry stops propagating at the first word that does not overflow,
+// which is much cheaper than Add(New(val)).
+func (u *Uint512) AddSmall(val uint64) *Uint512 {
+	result := u.Clone()
+	result.AddSmallInPlace(val)
+	return result
+}
+
+// AddSmallInPlace performs addition of a single word in place: u = u + val.
+func (u *Uint512) AddSmallInPlace(val uint64) {
+	carry := val
+	for i := 0; i < len(u.words) && carry != 0; i++ {
+		u.words[i], carry = bits.Add64(u.words[i], carry, 0)
+	}
+}
+
+// AddTo sets z = x + y and returns z.
+// Any of z, x and y may be the same value; nothing is allocated.
+func (z *Uint512) AddTo(x, y *Uint512) *Uint512 {
+	var carry uint64
+	for i := range z.words {
+		z.words[i], carry = bits.Add64(x.words[i], y.words[i], carry)
+	}
+	return z
+}
+
 // Sub performs subtraction: result = a - b.
 func (u *Uint512) Sub(other *Uint512) *Uint512 {
 	result := &Uint512{}
@@ -56,16 +85,321 @@ func (u *Uint512) SubInPlace(other *Uint512) {
 	}
 }
 
-// Uint1024 represents a 1024-bit result for multiplication
-type Uint1024 struct {
-	words [16]uint64
+// Neg performs two's complement negation: result = -a mod 2^512 = ^a + 1.
+// This is the additive inverse in Z/2^512Z, so a.Add(b.Neg()) equals a.Sub(b);
+// Neg(0) is 0 and Neg(1) is MAX.
+func (u *Uint512) Neg() *Uint512 {
+	result := &Uint512{}
+	var borrow uint64
+
+	for i := range u.words {
+		result.words[i], borrow = bits.Sub64(0, u.words[i], borrow)
+	}
+
+	return result
+}
+
+// Inc increments in place: u = u + 1 mod 2^512.
+// Returns true if the value wrapped from MAX to zero. The carry only ripples
+// through the trailing all-ones words, so most calls touch a single word.
+func (u *Uint512) Inc() bool {
+	for i := range u.words {
+		u.words[i]++
+		if u.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Dec decrements in place: u = u - 1 mod 2^512.
+// Returns true if the value wrapped from zero to MAX.
+func (u *Uint512) Dec() bool {
+	for i := range u.words {
+		u.words[i]--
+		if u.words[i] != ^uint64(0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Succ returns the successor: result = a + 1 mod 2^512.
+func (u *Uint512) Succ() *Uint512 {
+	result := u.Clone()
+	result.Inc()
+	return result
+}
+
+// Pred returns the predecessor: result = a - 1 mod 2^512.
+func (u *Uint512) Pred() *Uint512 {
+	result := u.Clone()
+	result.Dec()
+	return result
+}
+
+// AddOverflow performs addition and reports overflow: result = a + b mod 2^512.
+// The boolean is true if the sum carried out of bit 511, i.e. the result wrapped.
+func (u *Uint512) AddOverflow(other *Uint512) (*Uint512, bool) {
+	result, carry := u.AddWithCarry(other)
+	return result, carry != 0
+}
+
+// AddWithCarry performs addition and returns the carry: result = a + b mod 2^512.
+// The carry out of bit 511 is 0 or 1, ready to be added into the next more
+// significant limb when chaining Uint512 values into wider numbers.
+func (u *Uint512) AddWithCarry(other *Uint512) (*Uint512, uint64) {
+	result := &Uint512{}
+	var carry uint64
+
+	for i := range u.words {
+		result.words[i], carry = bits.Add64(u.words[i], other.words[i], carry)
+	}
+
+	return result, carry
+}
+
+// SubUnderflow performs subtraction and reports underflow: result = a - b mod 2^512.
+// The boolean is true if a < b, i.e. the result wrapped.
+func (u *Uint512) SubUnderflow(other *Uint512) (*Uint512, bool) {
+	result, borrow := u.SubWithBorrow(other)
+	return result, borrow != 0
+}
+
+// SubWithBorrow performs subtraction and returns the borrow: result = a - b mod 2^512.
+// The borrow is 1 if a < b and 0 otherwise, ready to be subtracted from the next
+// more significant limb when chaining Uint512 values into wider numbers.
+func (u *Uint512) SubWithBorrow(other *Uint512) (*Uint512, uint64) {
+	result := &Uint512{}
+	var borrow uint64
+
+	for i := range u.words {
+		result.words[i], borrow = bits.Sub64(u.words[i], other.words[i], borrow)
+	}
+
+	return result, borrow
+}
+
+// SubTo sets z = x - y and returns z.
+// Any of z, x and y may be the same value; nothing is allocated.
+func (z *Uint512) SubTo(x, y *Uint512) *Uint512 {
+	var borrow uint64
+	for i := range z.words {
+		z.words[i], borrow = bits.Sub64(x.words[i], y.words[i], borrow)
+	}
+	return z
+}
+
+// SubSmall performs subtraction of a single word: result = a - val mod 2^512.
+// Wraps on underflow like Sub; the borrow stops propagating at the first word
+// that does not underflow.
+func (u *Uint512) SubSmall(val uint64) *Uint512 {
+	result := u.Clone()
+	result.SubSmallInPlace(val)
+	return result
+}
+
+// SubSmallInPlace performs subtraction of a single word in place: u = u - val.
+func (u *Uint512) SubSmallInPlace(val uint64) {
+	borrow := val
+	for i := 0; i < len(u.words) && borrow != 0; i++ {
+		u.words[i], borrow = bits.Sub64(u.words[i], borrow, 0)
+	}
+}
+
+// AbsDiff returns the absolute difference: result = |a - b|.
+// Unlike Sub it never wraps, regardless of the order of the operands.
+func (u *Uint512) AbsDiff(other *Uint512) *Uint512 {
+	if u.Less(other) {
+		return other.Sub(u)
+	}
+	return u.Sub(other)
+}
+
+// AvgFloor returns the average rounded down: result = floor((a + b) / 2).
+// Computed as (a & b) + ((a ^ b) >> 1), the shared bits plus half the differing
+// ones, so the sum a + b is never formed and cannot wrap.
+func (u *Uint512) AvgFloor(other *Uint512) *Uint512 {
+	result := u.Xor(other)
+	result.ShrInPlace(1)
+	result.AddInPlace(u.And(other))
+	return result
+}
+
+// AvgCeil returns the average rounded up: result = ceil((a + b) / 2).
+// Computed as (a | b) - ((a ^ b) >> 1), which cannot wrap either.
+func (u *Uint512) AvgCeil(other *Uint512) *Uint512 {
+	half := u.Xor(other)
+	half.ShrInPlace(1)
+	result := u.Or(other)
+	result.SubInPlace(half)
+	return result
 }
 
 // Mul performs multiplication: result = a * b.
 // Uses the schoolbook multiplication algorithm.
-// Returns a Uint1024 to hold the full result.
-func (u *Uint512) Mul(other *Uint512) *Uint1024 {
-	result := &Uint1024{}
+// Returns a uint1024.Uint1024 to hold the full result.
+func (u *Uint512) Mul(other *Uint512) *uint1024.Uint1024 {
+	product := u.mulFull(other)
+	return uint1024.FromLimbs(product[:])
+}
+
+// MulOverflow performs multiplication and reports overflow: result = a * b mod 2^512.
+// The boolean is true if any bit of the exact product lies above bit 511.
+// The operand bit lengths la and lb usually settle the answer: the product lies
+// in [2^(la+lb-2), 2^(la+lb)), so it fits if la+lb <= 512 and overflows if
+// la+lb >= 514. Only in between is the full 1024-bit product computed.
+func (u *Uint512) MulOverflow(other *Uint512) (*Uint512, bool) {
+	la, lb := 512-u.LeadingZeros(), 512-other.LeadingZeros()
+	switch {
+	case la == 0 || lb == 0 || la+lb <= 512:
+		return u.mulLow(other), false
+	case la+lb >= 514:
+		return u.mulLow(other), true
+	}
+
+	product := u.mulFull(other)
+
+	result := &Uint512{}
+	copy(result.words[:], product[:8])
+
+	var high uint64
+	for _, word := range product[8:] {
+		high |= word
+	}
+	return result, high != 0
+}
+
+// CheckedAdd performs addition that refuses to wrap: result = a + b.
+// Returns ErrOverflow if the sum does not fit in 512 bits.
+func (u *Uint512) CheckedAdd(other *Uint512) (*Uint512, error) {
+	result, overflow := u.AddOverflow(other)
+	if overflow {
+		return nil, ErrOverflow
+	}
+	return result, nil
+}
+
+// CheckedSub performs subtraction that refuses to wrap: result = a - b.
+// Returns ErrUnderflow if b > a.
+func (u *Uint512) CheckedSub(other *Uint512) (*Uint512, error) {
+	result, underflow := u.SubUnderflow(other)
+	if underflow {
+		return nil, ErrUnderflow
+	}
+	return result, nil
+}
+
+// CheckedMul performs multiplication that refuses to wrap: result = a * b.
+// Returns ErrOverflow if the exact product does not fit in 512 bits.
+func (u *Uint512) CheckedMul(other *Uint512) (*Uint512, error) {
+	result, overflow := u.MulOverflow(other)
+	if overflow {
+		return nil, ErrOverflow
+	}
+	return result, nil
+}
+
+// SaturatingAdd performs addition clamped at MAX: result = min(a + b, MAX).
+func (u *Uint512) SaturatingAdd(other *Uint512) *Uint512 {
+	if result, overflow := u.AddOverflow(other); !overflow {
+		return result
+	}
+	return MAX.Clone()
+}
+
+// SaturatingSub performs subtraction clamped at zero: result = max(a - b, 0).
+func (u *Uint512) SaturatingSub(other *Uint512) *Uint512 {
+	if result, underflow := u.SubUnderflow(other); !underflow {
+		return result
+	}
+	return ZERO.Clone()
+}
+
+// SaturatingMul performs multiplication clamped at MAX: result = min(a * b, MAX).
+func (u *Uint512) SaturatingMul(other *Uint512) *Uint512 {
+	if result, overflow := u.MulOverflow(other); !overflow {
+		return result
+	}
+	return MAX.Clone()
+}
+
+// MulSmall performs multiplication by a single word: result = a * val mod 2^512.
+// Uses a single carry-propagation pass, which is much cheaper than Mul(New(val)).
+func (u *Uint512) MulSmall(val uint64) *Uint512 {
+	result := u.Clone()
+	result.MulSmallInPlace(val)
+	return result
+}
+
+// MulSmallInPlace performs multiplication by a single word in place: u = u * val.
+func (u *Uint512) MulSmallInPlace(val uint64) {
+	var carry uint64
+
+	for i := range u.words {
+		hi, lo := bits.Mul64(u.words[i], val)
+
+		var c uint64
+		u.words[i], c = bits.Add64(lo, carry, 0)
+		carry = hi + c
+	}
+}
+
+// MulHigh returns the high 512 bits of the product: result = (a * b) >> 512.
+// The result is exact: partial products of the low half still contribute their
+// carries. The product is accumulated in a stack-allocated scratch buffer and
+// only words 8..15 are copied out, avoiding the Uint1024 allocation of Mul.
+func (u *Uint512) MulHigh(other *Uint512) *Uint512 {
+	product := u.mulFull(other)
+
+	result := &Uint512{}
+	copy(result.words[:], product[8:])
+	return result
+}
+
+// MulAdd performs fused multiply-add: hi:lo = a * b + c.
+// The exact 1024-bit result is returned as two halves, where lo holds the least
+// significant 512 bits. It never overflows: (2^512-1)^2 + 2^512-1 < 2^1024.
+func (u *Uint512) MulAdd(other, addend *Uint512) (hi, lo *Uint512) {
+	product := u.mulFull(other)
+
+	var carry uint64
+	for i := range product {
+		var word uint64
+		if i < len(addend.words) {
+			word = addend.words[i]
+		} else if carry == 0 {
+			break
+		}
+		product[i], carry = bits.Add64(product[i], word, carry)
+	}
+
+	hi, lo = &Uint512{}, &Uint512{}
+	copy(lo.words[:], product[:8])
+	copy(hi.words[:], product[8:])
+	return hi, lo
+}
+
+// MulAddAcc accumulates a product into a 1024-bit value in place: hi:lo += a * b.
+// Returns the carry out of bit 1023 (0 or 1), so chained accumulations can
+// propagate it into further words instead of losing it.
+func (u *Uint512) MulAddAcc(other, hi, lo *Uint512) uint64 {
+	product := u.mulFull(other)
+
+	var carry uint64
+	for i := range lo.words {
+		lo.words[i], carry = bits.Add64(lo.words[i], product[i], carry)
+	}
+	for i := range hi.words {
+		hi.words[i], carry = bits.Add64(hi.words[i], product[i+8], carry)
+	}
+	return carry
+}
+
+// mulFull computes the exact 1024-bit product as 16 words in little-endian order.
+// Uses the schoolbook multiplication algorithm.
+func (u *Uint512) mulFull(other *Uint512) [16]uint64 {
+	var result [16]uint64
 
 	for i := range u.words {
 		if u.words[i] == 0 {
@@ -73,118 +407,262 @@ func (u *Uint512) Mul(other *Uint512) *Uint1024 {
 		}
 
 		var carry uint64
-		for j := 0; j < len(other.words) && i+j < len(result.words); j++ {
-			if other.words[j] == 0 {
-				continue
-			}
-
+		for j := range other.words {
 			hi, lo := bits.Mul64(u.words[i], other.words[j])
 
-			// Add lo to result[i+j]
-			sum, c1 := bits.Add64(result.words[i+j], lo, carry)
-			result.words[i+j] = sum
-			carry = c1
+			// Accumulate lo + carry + result[i+j]; the total always fits in hi:lo
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			result[i+j], c = bits.Add64(result[i+j], lo, 0)
+			carry = hi + c
+		}
+		result[i+len(other.words)] = carry
+	}
+
+	return result
+}
+
+// Square performs squaring: result = a * a.
+// Cross products a[i]*a[j] appear twice in the square, so they are computed
+// once and doubled, roughly halving the word multiplications of Mul.
+// Returns a uint1024.Uint1024 to hold the full result.
+func (u *Uint512) Square() *uint1024.Uint1024 {
+	square := u.sqrFull()
+	return uint1024.FromLimbs(square[:])
+}
 
-			// Add hi to result[i+j+1] if it exists
-			if i+j+1 < len(result.words) {
-				sum, c2 := bits.Add64(result.words[i+j+1], hi, carry)
-				result.words[i+j+1] = sum
-				carry = c2
-			}
+// sqrFull computes the exact 1024-bit square as 16 words in little-endian order.
+func (u *Uint512) sqrFull() [16]uint64 {
+	var result [16]uint64
+
+	// Accumulate the off-diagonal products a[i]*a[j] for i < j
+	for i := range u.words {
+		if u.words[i] == 0 {
+			continue
 		}
 
-		// Propagate remaining carry
-		k := i + len(other.words)
-		for carry != 0 && k < len(result.words) {
-			sum, c := bits.Add64(result.words[k], carry, 0)
-			result.words[k] = sum
-			carry = c
-			k++
+		var carry uint64
+		for j := i + 1; j < len(u.words); j++ {
+			hi, lo := bits.Mul64(u.words[i], u.words[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			result[i+j], c = bits.Add64(result[i+j], lo, 0)
+			carry = hi + c
 		}
+		result[i+len(u.words)] = carry
+	}
+
+	// Double the off-diagonal sum; it is below 2^1023, so nothing is shifted out
+	var top uint64
+	for i := range result {
+		next := result[i] >> 63
+		result[i] = result[i]<<1 | top
+		top = next
+	}
+
+	// Add the diagonal squares a[i]*a[i]
+	var carry uint64
+	for i := range u.words {
+		hi, lo := bits.Mul64(u.words[i], u.words[i])
+		result[2*i], carry = bits.Add64(result[2*i], lo, carry)
+		result[2*i+1], carry = bits.Add64(result[2*i+1], hi, carry)
 	}
 
 	return result
 }
 
-// String returns the decimal string representation of Uint1024.
-func (u1024 *Uint1024) String() string {
-	// Check if zero
-	isZero := true
-	for _, word := range u1024.words {
-		if word != 0 {
-			isZero = false
-			break
+// mulLow computes the low 512 bits of the product: result = a * b mod 2^512.
+// Partial products that only affect bits above position 511 are skipped.
+func (u *Uint512) mulLow(other *Uint512) *Uint512 {
+	result := &Uint512{}
+
+	for i := range u.words {
+		if u.words[i] == 0 {
+			continue
+		}
+
+		var carry uint64
+		for j := 0; i+j < len(result.words); j++ {
+			hi, lo := bits.Mul64(u.words[i], other.words[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			result.words[i+j], c = bits.Add64(result.words[i+j], lo, 0)
+			carry = hi + c
 		}
 	}
-	if isZero {
-		return "0"
+
+	return result
+}
+
+// Div performs division: result = a / b.
+// Returns quotient and error (if divisor is zero).
+func (u *Uint512) Div(other *Uint512) (*Uint512, error) {
+	quotient, _, err := u.DivMod(other)
+	return quotient, err
+}
+
+// Mod performs modulo operation: result = a % b.
+func (u *Uint512) Mod(other *Uint512) (*Uint512, error) {
+	_, remainder, err := u.DivMod(other)
+	return remainder, err
+}
+
+// DivMod performs division and modulo in a single pass: a = q * b + r.
+// Div and Mod are thin wrappers around it, so computing both through DivMod
+// costs half as much as calling them separately.
+// Returns quotient, remainder and error (if divisor is zero).
+func (u *Uint512) DivMod(other *Uint512) (q, r *Uint512, err error) {
+	if other.IsZero() {
+		return nil, nil, fmt.Errorf("division by zero")
 	}
 
-	// Convert to decimal using repeated division by 10
-	temp := &Uint1024{}
-	copy(temp.words[:], u1024.words[:])
-	var digits []byte
+	if u.Less(other) {
+		return ZERO.Clone(), u.Clone(), nil
+	}
 
-	for !temp.isZero() {
-		remainder := temp.divBySmall(10)
-		digits = append(digits, byte('0'+remainder))
+	if u.Equal(other) {
+		return ONE.Clone(), ZERO.Clone(), nil
 	}
 
-	// Reverse the digits
-	for i, j := 0, len(digits)-1; i < j; i, j = i+1, j-1 {
-		digits[i], digits[j] = digits[j], digits[i]
+	// Divisors that fit in one word take a single pass of 128-by-64-bit divisions
+	if other.IsUint64() {
+		quotient, remainder := u.divByWord(other.words[0])
+		return quotient, New(remainder), nil
 	}
 
-	return string(digits)
+	q, r = u.divModBits(other)
+	return q, r, nil
 }
 
-// isZero returns true if the Uint1024 is zero.
-func (u1024 *Uint1024) isZero() bool {
-	for _, word := range u1024.words {
-		if word != 0 {
-			return false
-		}
+// DivCeil performs division rounding up: result = ceil(a / b).
+// It never overflows: a non-zero remainder needs b >= 2, so q <= MAX/2.
+// Returns quotient and error (if divisor is zero).
+func (u *Uint512) DivCeil(other *Uint512) (*Uint512, error) {
+	q, r, err := u.DivMod(other)
+	if err != nil {
+		return nil, err
 	}
-	return true
+	if !r.IsZero() {
+		q.AddInPlace(ONE)
+	}
+	return q, nil
 }
 
-// divBySmall divides the Uint1024 by a small divisor and returns the remainder.
-func (u1024 *Uint1024) divBySmall(divisor uint64) uint64 {
-	var remainder uint64
-	for i := len(u1024.words) - 1; i >= 0; i-- {
-		dividend := remainder<<32 | u1024.words[i]>>32
-		u1024.words[i] = (u1024.words[i] & 0xFFFFFFFF) | (dividend/divisor)<<32
-		remainder = dividend % divisor
-
-		dividend = remainder<<32 | (u1024.words[i] & 0xFFFFFFFF)
-		u1024.words[i] = (u1024.words[i] & 0xFFFFFFFF00000000) | (dividend / divisor)
-		remainder = dividend % divisor
+// DivRound performs division rounding to nearest, with ties away from zero:
+// result = floor(a/b + 1/2). It never overflows, for the same reason as DivCeil.
+// Returns quotient and error (if divisor is zero).
+func (u *Uint512) DivRound(other *Uint512) (*Uint512, error) {
+	q, r, err := u.DivMod(other)
+	if err != nil {
+		return nil, err
 	}
-	return remainder
+	// 2r >= b, written so that 2r cannot overflow
+	if r.GreaterOrEqual(other.Sub(r)) {
+		q.AddInPlace(ONE)
+	}
+	return q, nil
 }
 
-// Div performs division: result = a / b.
-// Returns quotient and error (if divisor is zero).
-func (u *Uint512) Div(other *Uint512) (*Uint512, error) {
+// DivExact performs division that is known to be exact: result = a / b with a % b == 0.
+// Instead of long division it strips the common power of two and multiplies by
+// the inverse of the odd part of b modulo 2^512, computed one word at a time as
+// in Jebelean's exact division, so only a single 64-bit inverse is needed.
+// The quotient is verified by multiplying back.
+// Returns an error if b is zero or does not divide a.
+func (u *Uint512) DivExact(other *Uint512) (*Uint512, error) {
 	if other.IsZero() {
 		return nil, fmt.Errorf("division by zero")
 	}
 
-	if u.Less(other) {
-		return ZERO.Clone(), nil
+	s := other.TrailingZeros()
+	if u.TrailingZeros() < s {
+		return nil, fmt.Errorf("inexact division")
 	}
+	a, b := u.Shr(uint(s)), other.Shr(uint(s))
 
-	if u.Equal(other) {
-		return ONE.Clone(), nil
+	// b^-1 mod 2^64 by Newton's iteration, as for Montgomery multiplication
+	inv := b.words[0]
+	for i := 0; i < 5; i++ {
+		inv *= 2 - b.words[0]*inv
+	}
+
+	// Each quotient word clears the lowest remaining word of a; the subtraction of
+	// q[i]*b only affects words from i up
+	q := &Uint512{}
+	for i := range q.words {
+		q.words[i] = a.words[i] * inv
+
+		// The borrow is folded into the carry: q[i]*b[j] + carry is at most
+		// (2^64-1)*2^64, and at that bound the low word is zero and nothing is
+		// borrowed, so the carry fits in a word
+		var carry uint64
+		for j := 0; i+j < len(a.words); j++ {
+			hi, lo := bits.Mul64(q.words[i], b.words[j])
+
+			var c, borrow uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			a.words[i+j], borrow = bits.Sub64(a.words[i+j], lo, 0)
+			carry = hi + c + borrow
+		}
+	}
+
+	if product, overflow := q.MulOverflow(other); overflow || !product.Equal(u) {
+		return nil, fmt.Errorf("inexact division")
+	}
+	return q, nil
+}
+
+// DivSmall performs division by a single word: a = q * divisor + r.
+// Unlike DivMod it takes the divisor as a uint64 and returns the remainder as one,
+// leaving the receiver unchanged. Panics if divisor is zero.
+func (u *Uint512) DivSmall(divisor uint64) (*Uint512, uint64) {
+	if divisor == 0 {
+		panic("uint512: division by zero")
+	}
+	return u.divByWord(divisor)
+}
+
+// ModSmall performs modulo by a single word: result = a % divisor.
+// The receiver is left unchanged and nothing is allocated. Panics if divisor is zero.
+func (u *Uint512) ModSmall(divisor uint64) uint64 {
+	if divisor == 0 {
+		panic("uint512: division by zero")
+	}
+
+	var remainder uint64
+	for i := len(u.words) - 1; i >= 0; i-- {
+		_, remainder = bits.Div64(remainder, u.words[i], divisor)
 	}
+	return remainder
+}
 
+// divByWord divides by a single non-zero word and returns the quotient and remainder.
+func (u *Uint512) divByWord(divisor uint64) (*Uint512, uint64) {
+	quotient := &Uint512{}
+	var remainder uint64
+	for i := len(u.words) - 1; i >= 0; i-- {
+		quotient.words[i], remainder = bits.Div64(remainder, u.words[i], divisor)
+	}
+	return quotient, remainder
+}
+
+// divModBits divides using binary long division, one bit of the quotient per step.
+// other must be non-zero.
+func (u *Uint512) divModBits(other *Uint512) (q, r *Uint512) {
 	// Use binary long division
 	quotient := ZERO.Clone()
 	remainder := ZERO.Clone()
 
-	// Process bits from most significant to least significant
-	for i := 511; i >= 0; i-- {
-		// Shift remainder left by 1
+	// Process bits from the most significant set bit down to the least significant
+	for i := 512 - u.LeadingZeros() - 1; i >= 0; i-- {
+		// Shift remainder left by 1; since remainder < divisor it fits in 513 bits,
+		// and a bit carried out of the top word means it certainly exceeds the divisor
+		carry := remainder.words[7] >> 63
 		remainder.ShlInPlace(1)
 
 		// Set the least significant bit of remainder to the i-th bit of dividend
@@ -193,47 +671,340 @@ func (u *Uint512) Div(other *Uint512) (*Uint512, error) {
 		}
 
 		// If remainder >= divisor, subtract divisor and set quotient bit
-		if !remainder.Less(other) {
+		if carry != 0 || !remainder.Less(other) {
 			remainder.SubInPlace(other)
 			quotient.SetBit(i)
 		}
 	}
 
-	return quotient, nil
+	return quotient, remainder
 }
 
-// Mod performs modulo operation: result = a % b.
-func (u *Uint512) Mod(other *Uint512) (*Uint512, error) {
-	if other.IsZero() {
-		return nil, fmt.Errorf("division by zero")
+// Sqrt returns the integer square root: result = floor(√u).
+// Uses Newton's method x = (x + u/x) / 2 starting from the estimate u >> (BitLen/2),
+// which converges in O(log(BitLen)) iterations.
+func (u *Uint512) Sqrt() *Uint512 {
+	if u.IsZero() {
+		return ZERO.Clone()
 	}
 
-	if u.Less(other) {
+	bitLen := uint(512 - u.LeadingZeros())
+
+	// A Newton step from any positive estimate lands on or above floor(√u);
+	// from there the estimates decrease until they reach it
+	x := u.Shr(bitLen / 2)
+	next := u.sqrtStep(x)
+	for {
+		x = next
+		next = u.sqrtStep(x)
+		if !next.Less(x) {
+			return x
+		}
+	}
+}
+
+// SqrtRem returns the integer square root and its remainder: s = floor(√u), r = u - s².
+// Since s < 2^256, s² fits in 512 bits and r < 2s + 1.
+func (u *Uint512) SqrtRem() (s, r *Uint512) {
+	s = u.Sqrt()
+	return s, u.Sub(s.mulLow(s))
+}
+
+// sqrtStep performs a single Newton iteration for the square root: result = (x + u/x) / 2.
+// x must be non-zero.
+func (u *Uint512) sqrtStep(x *Uint512) *Uint512 {
+	result, _ := u.Div(x)
+	result.AddInPlace(x)
+	result.ShrInPlace(1)
+	return result
+}
+
+// Cbrt returns the integer cube root: result = floor(∛u).
+// Uses Newton's method x = (2x + u/x²) / 3 starting from 2^ceil(BitLen/3),
+// which is never below the root, so the estimates decrease until they reach it.
+func (u *Uint512) Cbrt() *Uint512 {
+	if u.IsZero() {
+		return ZERO.Clone()
+	}
+
+	bitLen := uint(512 - u.LeadingZeros())
+
+	x := ONE.Shl((bitLen + 2) / 3)
+	for {
+		next := u.cbrtStep(x)
+		if !next.Less(x) {
+			return x
+		}
+		x = next
+	}
+}
+
+// cbrtStep performs a single Newton iteration for the cube root: result = (2x + u/x²) / 3.
+// x must be non-zero and below 2^256 so that x² does not wrap.
+func (u *Uint512) cbrtStep(x *Uint512) *Uint512 {
+	result, _ := u.Div(x.mulLow(x))
+	result.AddInPlace(x.Shl(1))
+	result.divBySmall(3)
+	return result
+}
+
+// Pow performs exponentiation: result = a^exp mod 2^512.
+// Uses left-to-right binary exponentiation; overflow wraps without error.
+func (u *Uint512) Pow(exp uint) *Uint512 {
+	result := ONE.Clone()
+
+	for i := bits.Len(exp) - 1; i >= 0; i-- {
+		result = result.mulLow(result)
+		if exp&(1<<uint(i)) != 0 {
+			result = result.mulLow(u)
+		}
+	}
+
+	return result
+}
+
+// CheckedPow performs exponentiation that refuses to wrap: result = a^exp.
+// Uses left-to-right square-and-multiply, where every intermediate value is a
+// power of a not above a^exp, so the first intermediate that overflows proves
+// the result does not fit. Returns ErrOverflow if a^exp does not fit in 512 bits.
+func (u *Uint512) CheckedPow(exp uint64) (*Uint512, error) {
+	switch {
+	case exp == 0:
+		return ONE.Clone(), nil
+	case exp == 1:
+		return u.Clone(), nil
+	case u.IsZero() || u.Equal(ONE):
 		return u.Clone(), nil
+	case exp == 2:
+		result, overflow := u.MulOverflow(u)
+		if overflow {
+			return nil, ErrOverflow
+		}
+		return result, nil
 	}
 
-	if u.Equal(other) {
-		return ZERO.Clone(), nil
+	result := u.Clone()
+	for i := bits.Len64(exp) - 2; i >= 0; i-- {
+		var overflow bool
+		if result, overflow = result.MulOverflow(result); overflow {
+			return nil, ErrOverflow
+		}
+		if exp&(1<<uint(i)) != 0 {
+			if result, overflow = result.MulOverflow(u); overflow {
+				return nil, ErrOverflow
+			}
+		}
 	}
 
-	// Use binary long division to compute remainder
-	remainder := ZERO.Clone()
+	return result, nil
+}
 
-	// Process bits from most significant to least significant
-	for i := 511; i >= 0; i-- {
-		// Shift remainder left by 1
-		remainder.ShlInPlace(1)
+// AddMod performs modular addition: result = (a + b) % mod.
+// Both operands must already be reduced (less than mod), so the sum is below 2*mod
+// and a single conditional subtraction replaces the division; the carry out of
+// bit 511 is taken into account, so mod may use the full width.
+// Panics if mod is zero; ModAdd accepts unreduced operands and returns an error instead.
+func (u *Uint512) AddMod(other, mod *Uint512) *Uint512 {
+	if mod.IsZero() {
+		panic("uint512: zero modulus")
+	}
 
-		// Set the least significant bit of remainder to the i-th bit of dividend
-		if u.Bit(i) {
-			remainder.words[0] |= 1
+	result := &Uint512{}
+	var carry uint64
+	for i := range u.words {
+		result.words[i], carry = bits.Add64(u.words[i], other.words[i], carry)
+	}
+
+	if carry != 0 || !result.Less(mod) {
+		result.SubInPlace(mod)
+	}
+	return result
+}
+
+// SubMod performs modular subtraction: result = (a - b) % mod.
+// Both operands must already be reduced (less than mod); if a < b the wrapped
+// difference is corrected by a single addition of mod instead of a division.
+// Panics if mod is zero; ModSub accepts unreduced operands and returns an error instead.
+func (u *Uint512) SubMod(other, mod *Uint512) *Uint512 {
+	if mod.IsZero() {
+		panic("uint512: zero modulus")
+	}
+
+	result := &Uint512{}
+	var borrow uint64
+	for i := range u.words {
+		result.words[i], borrow = bits.Sub64(u.words[i], other.words[i], borrow)
+	}
+
+	if borrow != 0 {
+		result.AddInPlace(mod)
+	}
+	return result
+}
+
+// ModAdd performs modular addition of unreduced operands: result = (a + b) % mod.
+// Operands at or above mod are reduced first, so the sum never wraps past 2^512
+// before the reduction. Returns an error if mod is zero, where AddMod panics.
+func (u *Uint512) ModAdd(other, mod *Uint512) (*Uint512, error) {
+	if mod.IsZero() {
+		return nil, fmt.Errorf("division by zero")
+	}
+	return u.reduce(mod).AddMod(other.reduce(mod), mod), nil
+}
+
+// ModSub performs modular subtraction of unreduced operands: result = (a - b) % mod.
+// Operands at or above mod are reduced first; if a < b after reduction, mod is
+// added to the difference. Returns an error if mod is zero, where SubMod panics.
+func (u *Uint512) ModSub(other, mod *Uint512) (*Uint512, error) {
+	if mod.IsZero() {
+		return nil, fmt.Errorf("division by zero")
+	}
+	return u.reduce(mod).SubMod(other.reduce(mod), mod), nil
+}
+
+// ModMul performs modular multiplication of unreduced operands: result = (a * b) % mod.
+// Same as MulMod, reducing the exact 1024-bit product, but returns an error
+// instead of panicking if mod is zero.
+func (u *Uint512) ModMul(other, mod *Uint512) (*Uint512, error) {
+	if mod.IsZero() {
+		return nil, fmt.Errorf("division by zero")
+	}
+	return u.mulMod(other, mod), nil
+}
+
+// reduce returns u % mod, skipping the division when u is already below mod.
+// mod must be non-zero.
+func (u *Uint512) reduce(mod *Uint512) *Uint512 {
+	if u.Less(mod) {
+		return u
+	}
+	r, _ := u.Mod(mod)
+	return r
+}
+
+// PowMod performs modular exponentiation: result = a^exp % mod.
+// Intermediate values are reduced at every step, so they never exceed 512 bits.
+// Odd moduli use Montgomery multiplication, even ones word-based division.
+// Returns an error if mod is zero.
+func (u *Uint512) PowMod(exp uint, mod *Uint512) (*Uint512, error) {
+	if mod.IsZero() {
+		return nil, fmt.Errorf("division by zero")
+	}
+
+	// Odd moduli take the division-free Montgomery path
+	if ctx, err := NewMontgomeryContext(mod); err == nil {
+		return ctx.exp(u, New(uint64(exp))), nil
+	}
+
+	var wide [16]uint64
+	copy(wide[:], u.words[:])
+	base := reduceWide(&wide, mod)
+
+	// Start from 1 % mod, which is zero when mod is one
+	result := ONE.Clone()
+	if mod.Equal(ONE) {
+		result = ZERO.Clone()
+	}
+
+	for i := bits.Len(exp) - 1; i >= 0; i-- {
+		result = result.mulMod(result, mod)
+		if exp&(1<<uint(i)) != 0 {
+			result = result.mulMod(base, mod)
 		}
+	}
 
-		// If remainder >= divisor, subtract divisor
-		if !remainder.Less(other) {
-			remainder.SubInPlace(other)
+	return result, nil
+}
+
+// GCD computes the greatest common divisor of a and b with the binary (Stein)
+// algorithm, which only shifts and subtracts. GCD(0, b) is b and GCD(0, 0) is 0.
+func (u *Uint512) GCD(other *Uint512) *Uint512 {
+	if u.IsZero() {
+		return other.Clone()
+	}
+	if other.IsZero() {
+		return u.Clone()
+	}
+
+	// The common power of two, restored at the end
+	shift := u.TrailingZeros()
+	if tz := other.TrailingZeros(); tz < shift {
+		shift = tz
+	}
+
+	// Both values stay odd at the top of each step; their difference is even
+	a := u.Shr(uint(u.TrailingZeros()))
+	b := other.Clone()
+	for !b.IsZero() {
+		b.ShrInPlace(uint(b.TrailingZeros()))
+		if a.Greater(b) {
+			a, b = b, a
 		}
+		b.SubInPlace(a)
+	}
+
+	return a.Shl(uint(shift))
+}
+
+// LCM computes the least common multiple of a and b as a / GCD(a, b) * b.
+// LCM(0, b) is 0. Returns ErrOverflow if the result does not fit in 512 bits.
+func (u *Uint512) LCM(other *Uint512) (*Uint512, error) {
+	if u.IsZero() || other.IsZero() {
+		return ZERO.Clone(), nil
 	}
 
-	return remainder, nil
+	quotient, _ := u.DivExact(u.GCD(other))
+	return quotient.CheckedMul(other)
+}
+
+// modInverse computes the modular inverse: result = x with (a * x) % mod == 1.
+// Runs the extended Euclidean algorithm on unsigned values, keeping the Bézout
+// coefficient reduced modulo mod so that no negative numbers arise; mod may be even.
+// Returns ErrNotInvertible if gcd(a, mod) != 1 or mod is zero or one.
+func (u *Uint512) modInverse(mod *Uint512) (*Uint512, error) {
+	if mod.IsZero() || mod.Equal(ONE) {
+		return nil, ErrNotInvertible
+	}
+
+	// Invariant: r0 ≡ t0*a and r1 ≡ t1*a (mod m)
+	r0, r1 := mod.Clone(), u.reduce(mod)
+	t0, t1 := ZERO.Clone(), ONE.Clone()
+	for !r1.IsZero() {
+		q, r, _ := r0.DivMod(r1)
+		r0, r1 = r1, r
+		t0, t1 = t1, t0.SubMod(q.mulMod(t1, mod), mod)
+	}
+
+	if !r0.Equal(ONE) {
+		return nil, ErrNotInvertible
+	}
+	return t0, nil
+}
+
+// MulMod performs modular multiplication: result = (a * b) % mod.
+// The exact 1024-bit product is reduced with word-based long division, so no bits
+// are lost and the operands need not be reduced beforehand.
+// Panics if mod is zero; ModMul returns an error instead.
+func (u *Uint512) MulMod(other, mod *Uint512) *Uint512 {
+	if mod.IsZero() {
+		panic("uint512: zero modulus")
+	}
+	return u.mulMod(other, mod)
+}
+
+// mulMod computes (a * b) % mod using the full 1024-bit product, so no bits are lost.
+// mod must be non-zero.
+func (u *Uint512) mulMod(other, mod *Uint512) *Uint512 {
+	product := u.mulFull(other)
+	return reduceWide(&product, mod)
+}
+
+// reduceWide computes x % mod for a 1024-bit value x given as 16 little-endian words.
+// Delegates to the word-based division of uint1024; mod must be non-zero.
+func reduceWide(x *[16]uint64, mod *Uint512) *Uint512 {
+	remainder, _ := uint1024.FromLimbs(x[:]).Mod(uint1024.FromLimbs(mod.words[:]))
+
+	// The remainder is below mod, so it fits in the low 8 words
+	limbs := remainder.Limbs()
+	return FromLimbs(limbs[:8])
 }
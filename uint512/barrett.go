@@ -0,0 +1,130 @@
+// barrett.go implements Barrett reduction for Uint512
+package uint512
+
+import (
+	"math/bits"
+
+	"github.com/Alivers/guint/uint1024"
+)
+
+// BarrettReducer reduces 1024-bit values modulo a fixed modulus m using the
+// precomputed reciprocal μ = floor(2^1024 / m), replacing each division by
+// two multiplications.
+type BarrettReducer struct {
+	// mod is the modulus m
+	mod Uint512
+	// mu holds the significant words of floor(2^1024 / m); empty when m is one
+	mu []uint64
+}
+
+// NewBarrettReducer precomputes μ = floor(2^1024 / mod).
+// Panics if mod is zero.
+func NewBarrettReducer(mod *Uint512) *BarrettReducer {
+	if mod.IsZero() {
+		panic("uint512: zero modulus")
+	}
+
+	br := &BarrettReducer{mod: *mod}
+	if mod.Equal(ONE) {
+		return br
+	}
+
+	// 2^1024 itself does not fit in a Uint1024, so divide 2^1024 - 1 and round up
+	// when the remainder shows that m divides 2^1024
+	m := uint1024.FromLimbs(mod.words[:])
+	q, r, _ := uint1024.MAX.DivMod(m)
+	if r.Equal(m.SubSmall(1)) {
+		q.AddSmallInPlace(1)
+	}
+
+	limbs := q.Limbs()
+	n := len(limbs)
+	for n > 0 && limbs[n-1] == 0 {
+		n--
+	}
+	br.mu = append([]uint64(nil), limbs[:n]...)
+	return br
+}
+
+// Modulus returns a copy of the modulus of the reducer.
+func (br *BarrettReducer) Modulus() *Uint512 {
+	return br.mod.Clone()
+}
+
+// Reduce computes x % m.
+// With k the number of significant words of m, the quotient estimate
+// q = floor(floor(x / 2^(64(k-1))) * μ / 2^(64(17-k))) is at most two below
+// floor(x/m), so x - q*m is below 3m and at most two subtractions of m are needed.
+// Dropping the low k-1 words of x first keeps the multiplication short.
+func (br *BarrettReducer) Reduce(x *uint1024.Uint1024) *Uint512 {
+	if len(br.mu) == 0 {
+		return ZERO.Clone()
+	}
+
+	xw := x.Limbs()
+	k := len(br.mod.words)
+	for br.mod.words[k-1] == 0 {
+		k--
+	}
+
+	// floor(x / 2^(64(k-1))) * μ; only the words from 17-k upwards, which form q,
+	// are needed afterwards
+	high := xw[k-1:]
+	var product [16 + 17]uint64
+	for i, mu := range br.mu {
+		var carry uint64
+		for j := range high {
+			hi, lo := bits.Mul64(mu, high[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			product[i+j], c = bits.Add64(product[i+j], lo, 0)
+			carry = hi + c
+		}
+		product[i+len(high)] = carry
+	}
+	q := product[17-k:]
+
+	// r = x - q*m modulo 2^576: the remainder estimate is below 3m < 2^514,
+	// so the low nine words of both terms determine it
+	var qm [9]uint64
+	for i := 0; i < len(qm); i++ {
+		if q[i] == 0 {
+			continue
+		}
+
+		var carry uint64
+		for j := 0; i+j < len(qm) && j < len(br.mod.words); j++ {
+			hi, lo := bits.Mul64(q[i], br.mod.words[j])
+
+			var c uint64
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			qm[i+j], c = bits.Add64(qm[i+j], lo, 0)
+			carry = hi + c
+		}
+		if i+len(br.mod.words) < len(qm) {
+			qm[i+len(br.mod.words)] += carry
+		}
+	}
+
+	var r [9]uint64
+	var borrow uint64
+	for i := range r {
+		r[i], borrow = bits.Sub64(xw[i], qm[i], borrow)
+	}
+
+	// At most two corrections
+	result := &Uint512{}
+	copy(result.words[:], r[:8])
+	for r[8] != 0 || !result.Less(&br.mod) {
+		borrow = 0
+		for i := range result.words {
+			result.words[i], borrow = bits.Sub64(result.words[i], br.mod.words[i], borrow)
+		}
+		r[8] -= borrow
+	}
+
+	return result
+}
@@ -0,0 +1,84 @@
+package uint512
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/Alivers/guint/uint1024"
+)
+
+// TestBarrettReducerExhaustive checks every dividend below mod^2 + mod for every small modulus
+func TestBarrettReducerExhaustive(t *testing.T) {
+	for m := uint64(1); m <= 100; m++ {
+		br := NewBarrettReducer(New(m))
+		for x := uint64(0); x < m*m+m; x++ {
+			if got := br.Reduce(uint1024.New(x)); !got.Equal(New(x % m)) {
+				t.Fatalf("%d mod %d = %s, want %d", x, m, got, x%m)
+			}
+		}
+	}
+}
+
+// TestBarrettReducer cross-checks reduction of full-width values against math/big
+func TestBarrettReducer(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	moduli := []*Uint512{ONE, New(2), New(3), ONE.Shl(64), ONE.Shl(511), MAX, MAX.Shr(1), New(1000000007)}
+	for i := 0; i < 30; i++ {
+		moduli = append(moduli, randUint512(r))
+	}
+
+	for _, m := range moduli {
+		if m.IsZero() {
+			continue
+		}
+		br := NewBarrettReducer(m)
+		if !br.Modulus().Equal(m) {
+			t.Errorf("Modulus() = %s, want %s", br.Modulus(), m)
+		}
+
+		values := []*uint1024.Uint1024{uint1024.ZERO, uint1024.MAX, uint1024.FromLimbs(m.words[:])}
+		for i := 0; i < 30; i++ {
+			limbs := make([]uint64, r.Intn(16)+1)
+			for k := range limbs {
+				limbs[k] = r.Uint64()
+			}
+			values = append(values, uint1024.FromLimbs(limbs))
+		}
+
+		for _, x := range values {
+			xBig := new(big.Int).SetBytes(x.ToBeBytes())
+			want := new(big.Int).Mod(xBig, toBig(m))
+			if got := br.Reduce(x); toBig(got).Cmp(want) != 0 {
+				t.Errorf("%s mod %s = %s, want %s", x, m, got, want)
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewBarrettReducer(0): expected panic")
+		}
+	}()
+	NewBarrettReducer(ZERO)
+}
+
+// BenchmarkBarrettReducer compares Barrett reduction of a 1024-bit value against Uint1024.Mod
+func BenchmarkBarrettReducer(b *testing.B) {
+	m := MAX.Sub(New(188))
+	x := uint1024.MAX.Sub(uint1024.New(12345))
+	br := NewBarrettReducer(m)
+
+	b.Run("Reduce", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			br.Reduce(x)
+		}
+	})
+	b.Run("Mod", func(b *testing.B) {
+		m1024 := uint1024.FromLimbs(m.words[:])
+		for i := 0; i < b.N; i++ {
+			x.Mod(m1024)
+		}
+	})
+}
@@ -1,7 +1,10 @@
 // bitwise.go implements bitwise operations for Uint512
 package uint512
 
-import "math/bits"
+import (
+	"fmt"
+	"math/bits"
+)
 
 // And performs bitwise AND: result = a & b.
 func (u *Uint512) And(other *Uint512) *Uint512 {
@@ -19,6 +22,15 @@ func (u *Uint512) AndInPlace(other *Uint512) {
 	}
 }
 
+// AndTo sets z = x & y and returns z.
+// Any of z, x and y may be the same value; nothing is allocated.
+func (z *Uint512) AndTo(x, y *Uint512) *Uint512 {
+	for i := range z.words {
+		z.words[i] = x.words[i] & y.words[i]
+	}
+	return z
+}
+
 // Or performs bitwise OR: result = a | b.
 func (u *Uint512) Or(other *Uint512) *Uint512 {
 	result := &Uint512{}
@@ -35,6 +47,15 @@ func (u *Uint512) OrInPlace(other *Uint512) {
 	}
 }
 
+// OrTo sets z = x | y and returns z.
+// Any of z, x and y may be the same value; nothing is allocated.
+func (z *Uint512) OrTo(x, y *Uint512) *Uint512 {
+	for i := range z.words {
+		z.words[i] = x.words[i] | y.words[i]
+	}
+	return z
+}
+
 // Xor performs bitwise XOR: result = a ^ b.
 func (u *Uint512) Xor(other *Uint512) *Uint512 {
 	result := &Uint512{}
@@ -51,6 +72,15 @@ func (u *Uint512) XorInPlace(other *Uint512) {
 	}
 }
 
+// XorTo sets z = x ^ y and returns z.
+// Any of z, x and y may be the same value; nothing is allocated.
+func (z *Uint512) XorTo(x, y *Uint512) *Uint512 {
+	for i := range z.words {
+		z.words[i] = x.words[i] ^ y.words[i]
+	}
+	return z
+}
+
 // Not performs bitwise NOT: result = ^a.
 func (u *Uint512) Not() *Uint512 {
 	result := &Uint512{}
@@ -112,6 +142,14 @@ func (u *Uint512) ShlInPlace(n uint) {
 	}
 }
 
+// ShlTo sets z = x << n and returns z.
+// z and x may be the same value; nothing is allocated.
+func (z *Uint512) ShlTo(x *Uint512, n uint) *Uint512 {
+	z.words = x.words
+	z.ShlInPlace(n)
+	return z
+}
+
 // Shr performs right shift: result = a >> n.
 func (u *Uint512) Shr(n uint) *Uint512 {
 	result := u.Clone()
@@ -157,6 +195,34 @@ func (u *Uint512) ShrInPlace(n uint) {
 	}
 }
 
+// ShrTo sets z = x >> n and returns z.
+// z and x may be the same value; nothing is allocated.
+func (z *Uint512) ShrTo(x *Uint512, n uint) *Uint512 {
+	z.words = x.words
+	z.ShrInPlace(n)
+	return z
+}
+
+// ReverseBits returns the value with its bit order reversed: bit i moves to bit 511-i.
+// The word order is reversed and each word is bit-reversed.
+func (u *Uint512) ReverseBits() *Uint512 {
+	result := &Uint512{}
+	for i, word := range u.words {
+		result.words[len(u.words)-1-i] = bits.Reverse64(word)
+	}
+	return result
+}
+
+// ReverseBytes returns the value with its byte order reversed: byte i moves to
+// byte 63-i. It equals FromBeBytes(u.ToLeBytes()) without allocating a byte slice.
+func (u *Uint512) ReverseBytes() *Uint512 {
+	result := &Uint512{}
+	for i, word := range u.words {
+		result.words[len(u.words)-1-i] = bits.ReverseBytes64(word)
+	}
+	return result
+}
+
 // Bit returns the value of the bit at position i (0 is least significant).
 func (u *Uint512) Bit(i int) bool {
 	if i < 0 || i >= 512 {
@@ -197,7 +263,46 @@ func (u *Uint512) FlipBit(i int) {
 	u.words[wordIndex] ^= (1 << bitIndex)
 }
 
-// LeadingZeros returns the number of leading zero bits.
+// RotateLeft performs left rotation: bits shifted out at the top re-enter at the bottom.
+// n is taken modulo 512, so the result equals Shl(n % 512).Or(Shr(512 - n % 512)).
+func (u *Uint512) RotateLeft(n uint) *Uint512 {
+	result := u.Clone()
+	result.RotateLeftInPlace(n)
+	return result
+}
+
+// RotateLeftInPlace performs left rotation in place, with n taken modulo 512.
+func (u *Uint512) RotateLeftInPlace(n uint) {
+	n %= 512
+	if n == 0 {
+		return
+	}
+
+	// Each result word takes its high bits from the word wordShift below it and
+	// its low bits from the top of the word after that; a shift by 64 yields 0
+	wordShift := n / 64
+	bitShift := n % 64
+	src := u.words
+	for i := range u.words {
+		j := uint(i) + 8 - wordShift
+		u.words[i] = src[j%8]<<bitShift | src[(j+7)%8]>>(64-bitShift)
+	}
+}
+
+// RotateRight performs right rotation: bits shifted out at the bottom re-enter at the top.
+// n is taken modulo 512, so the result equals RotateLeft(512 - n % 512).
+func (u *Uint512) RotateRight(n uint) *Uint512 {
+	result := u.Clone()
+	result.RotateRightInPlace(n)
+	return result
+}
+
+// RotateRightInPlace performs right rotation in place, with n taken modulo 512.
+func (u *Uint512) RotateRightInPlace(n uint) {
+	u.RotateLeftInPlace(512 - n%512)
+}
+
+// LeadingZeros returns the number of leading zero bits, or 512 for zero.
 func (u *Uint512) LeadingZeros() int {
 	for i := len(u.words) - 1; i >= 0; i-- {
 		if u.words[i] != 0 {
@@ -207,7 +312,23 @@ func (u *Uint512) LeadingZeros() int {
 	return 512
 }
 
-// TrailingZeros returns the number of trailing zero bits.
+// BitLen returns the number of bits needed to represent the value:
+// the position of the highest set bit plus one, or 0 for zero.
+func (u *Uint512) BitLen() int {
+	return 512 - u.LeadingZeros()
+}
+
+// Log2 returns the integer base-2 logarithm: result = floor(log2(u)) = BitLen() - 1.
+// Returns an error if the value is zero.
+func (u *Uint512) Log2() (uint, error) {
+	n := u.BitLen()
+	if n == 0 {
+		return 0, fmt.Errorf("logarithm of zero")
+	}
+	return uint(n - 1), nil
+}
+
+// TrailingZeros returns the number of trailing zero bits, or 512 for zero.
 func (u *Uint512) TrailingZeros() int {
 	for i := 0; i < len(u.words); i++ {
 		if u.words[i] != 0 {
@@ -217,7 +338,7 @@ func (u *Uint512) TrailingZeros() int {
 	return 512
 }
 
-// OnesCount returns the number of one bits (population count).
+// OnesCount returns the number of one bits (population count), or 0 for zero.
 func (u *Uint512) OnesCount() int {
 	count := 0
 	for _, word := range u.words {
@@ -225,3 +346,37 @@ func (u *Uint512) OnesCount() int {
 	}
 	return count
 }
+
+// TruncateToBits returns a copy of u with every bit at position n or above cleared,
+// so that the result fits in n bits.
+// If n >= 512 the value is returned unchanged; if n == 0 the result is zero.
+func (u *Uint512) TruncateToBits(n uint) *Uint512 {
+	result := u.Clone()
+	result.TruncateToBitsInPlace(n)
+	return result
+}
+
+// TruncateToBitsInPlace clears every bit at position n or above in place.
+func (u *Uint512) TruncateToBitsInPlace(n uint) {
+	if n >= 512 {
+		return
+	}
+
+	wordIndex := n / 64
+	bitIndex := n % 64
+
+	// Keep the low bitIndex bits of the boundary word and clear everything above it
+	u.words[wordIndex] &= (1 << bitIndex) - 1
+	for i := int(wordIndex) + 1; i < len(u.words); i++ {
+		u.words[i] = 0
+	}
+}
+
+// FitsBits returns true if the value can be represented in n bits,
+// i.e. no bit at position n or above is set.
+func (u *Uint512) FitsBits(n uint) bool {
+	if n >= 512 {
+		return true
+	}
+	return 512-u.LeadingZeros() <= int(n)
+}
@@ -2,6 +2,9 @@ package uint512
 
 import (
 	"bytes"
+	"math"
+	"math/big"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -251,3 +254,196 @@ func TestRoundTripConversions(t *testing.T) {
 		t.Error("Big-endian bytes round trip failed")
 	}
 }
+
+// TestUint64 tests narrowing to uint64
+func TestUint64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *Uint512
+		expected uint64
+		fits     bool
+	}{
+		{"Zero", ZERO.Clone(), 0, true},
+		{"Small value", New(42), 42, true},
+		{"Max uint64", New(^uint64(0)), ^uint64(0), true},
+		{"2^64 (truncated)", ONE.Shl(64), 0, false},
+		{"High limb only (truncated)", FromLimbs([]uint64{7, 0, 0, 0, 0, 0, 0, 1}), 7, false},
+		{"MAX (truncated)", MAX.Clone(), ^uint64(0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Uint64(); got != tt.expected {
+				t.Errorf("Uint64() = %d, want %d", got, tt.expected)
+			}
+			if got := tt.input.IsUint64(); got != tt.fits {
+				t.Errorf("IsUint64() = %t, want %t", got, tt.fits)
+			}
+		})
+	}
+}
+
+// TestFloat64 tests conversion to float64 against big.Float
+func TestFloat64(t *testing.T) {
+	check := func(u *Uint512) {
+		t.Helper()
+		got, gotAcc := u.Float64()
+		want, wantAcc := new(big.Float).SetInt(toBig(u)).Float64()
+		if got != want || gotAcc != wantAcc {
+			t.Errorf("Float64(%s) = (%g, %v), want (%g, %v)", u.Hex(), got, gotAcc, want, wantAcc)
+		}
+	}
+
+	tests := []*Uint512{
+		ZERO.Clone(),
+		ONE.Clone(),
+		New(1 << 53),
+		New(1<<53 + 1), // Halfway, rounds down to even
+		New(1<<53 + 3), // Halfway, rounds up to even
+		New(^uint64(0)),
+		ONE.Shl(511),
+		MAX.Clone(),                             // Rounds up to 2^512
+		MAX.Shr(1),                              // Rounds up to 2^511
+		ONE.Shl(511).Add(ONE.Shl(458)),          // Exactly halfway between two floats
+		ONE.Shl(511).Add(ONE.Shl(458)).Add(ONE), // Just above halfway
+	}
+	for _, u := range tests {
+		check(u)
+	}
+
+	if f, _ := MAX.Float64(); math.IsInf(f, 0) || f != math.Ldexp(1, 512) {
+		t.Errorf("MAX.Float64() = %g, want 2^512", f)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		check(randUint512(r))
+	}
+}
+
+// TestWordAccess tests the limb accessors
+func TestWordAccess(t *testing.T) {
+	u := FromLimbs([]uint64{1, 2, 3, 4, 5, 6, 7, 8})
+
+	if u.NumWords() != 8 {
+		t.Errorf("NumWords() = %d, want 8", u.NumWords())
+	}
+	for i := 0; i < u.NumWords(); i++ {
+		if u.Word(i) != uint64(i+1) {
+			t.Errorf("Word(%d) = %d, want %d", i, u.Word(i), i+1)
+		}
+	}
+
+	u.SetWord(0, 42)
+	u.SetWord(7, ^uint64(0))
+	expected := FromLimbs([]uint64{42, 2, 3, 4, 5, 6, 7, ^uint64(0)})
+	if !u.Equal(expected) {
+		t.Errorf("SetWord: got %v, want %v", u.ToLimbs(), expected.ToLimbs())
+	}
+
+	for _, i := range []int{-1, 8, 100} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Word(%d) should panic", i)
+				}
+			}()
+			u.Word(i)
+		}()
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("SetWord(%d) should panic", i)
+				}
+			}()
+			u.SetWord(i, 1)
+		}()
+	}
+}
+
+// TestSet tests the setters used to reuse allocations
+func TestSet(t *testing.T) {
+	x := FromLimbs([]uint64{1, 2, 3, 4, 5, 6, 7, 8})
+
+	u := New(99)
+	if result := u.Set(x); result != u || !u.Equal(x) {
+		t.Errorf("Set should copy the value into the receiver and return it")
+	}
+
+	// The copy must not alias x
+	u.SetWord(0, 42)
+	if x.Word(0) != 1 {
+		t.Error("Set should not alias its argument")
+	}
+
+	if result := u.SetUint64(7); result != u || !u.Equal(New(7)) {
+		t.Errorf("SetUint64(7) = %s, want 7", u.String())
+	}
+	if result := u.SetZero(); result != u || !u.IsZero() {
+		t.Errorf("SetZero() = %s, want 0", u.String())
+	}
+}
+
+// TestSetAllocations tests that a loop built on Set and InPlace methods does not allocate
+func TestSetAllocations(t *testing.T) {
+	step := New(3)
+	acc := New(0)
+	scratch := New(0)
+
+	allocs := testing.AllocsPerRun(1000, func() {
+		scratch.Set(acc)
+		scratch.ShlInPlace(1)
+		scratch.AddInPlace(step)
+		acc.Set(scratch)
+		if acc.IsZero() {
+			acc.SetUint64(1)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("Set-based loop allocated %v times per iteration, want 0", allocs)
+	}
+}
+
+// TestKey tests the comparable map key representation
+func TestKey(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		u := randUint512(r)
+		key := u.Key()
+		if !bytes.Equal(key[:], u.ToBeBytes()) {
+			t.Errorf("Key() = %x, want %x", key, u.ToBeBytes())
+		}
+		if !FromBeBytes(key[:]).Equal(u) {
+			t.Error("Key round trip failed")
+		}
+	}
+
+	set := map[[64]byte]struct{}{}
+	set[New(1).Key()] = struct{}{}
+	set[ONE.Clone().Key()] = struct{}{}
+	set[New(2).Key()] = struct{}{}
+	if len(set) != 2 {
+		t.Errorf("equal values should map to the same key, got %d distinct keys", len(set))
+	}
+}
+
+// TestHash64 tests the non-cryptographic hash
+func TestHash64(t *testing.T) {
+	u := FromLimbs([]uint64{1, 2, 3, 4, 5, 6, 7, 8})
+	if u.Hash64(0) != u.Clone().Hash64(0) {
+		t.Error("Hash64 should be deterministic")
+	}
+	if u.Hash64(0) == u.Hash64(1) {
+		t.Error("Hash64 should depend on the seed")
+	}
+
+	// Values differing in a single bit should not collide
+	seen := map[uint64]int{}
+	for i := 0; i < 512; i++ {
+		seen[ONE.Shl(uint(i)).Hash64(42)] = i
+	}
+	seen[ZERO.Hash64(42)] = -1
+	if len(seen) != 513 {
+		t.Errorf("Hash64 produced %d distinct values for 513 inputs", len(seen))
+	}
+}
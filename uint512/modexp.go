@@ -0,0 +1,57 @@
+// modexp.go implements constant-time modular exponentiation for Uint512
+package uint512
+
+import "crypto/subtle"
+
+// modExpWindow is the number of exponent bits ModExpCT consumes per multiplication.
+// It divides 64, so no window straddles two words of the exponent.
+const modExpWindow = 4
+
+// ModExpCT performs modular exponentiation for secret exponents: result = a^exp % mod.
+// It runs in time independent of the bits of exp: all 512 exponent bits are
+// processed in fixed windows of modExpWindow bits, every window costs the same
+// squarings and one multiplication, the table entry for a window is selected by
+// scanning the whole table with masks, and Montgomery reductions subtract by
+// masking rather than branching. Timing may still depend on the base and the
+// modulus, which are treated as public.
+// Returns an error if mod is zero or even.
+func (u *Uint512) ModExpCT(exp, mod *Uint512) (*Uint512, error) {
+	ctx, err := NewMontgomeryContext(mod)
+	if err != nil {
+		return nil, err
+	}
+
+	// table[i] = a^i in Montgomery form
+	var table [1 << modExpWindow]Uint512
+	table[0] = ctx.r
+	table[1] = *ctx.Enter(u)
+	for i := 2; i < len(table); i++ {
+		ctx.mulCT(&table[i], &table[i-1], &table[1])
+	}
+
+	result := ctx.r.Clone()
+	var entry Uint512
+	for i := 512 - modExpWindow; i >= 0; i -= modExpWindow {
+		for j := 0; j < modExpWindow; j++ {
+			ctx.mulCT(result, result, result)
+		}
+
+		window := exp.words[i/64] >> uint(i%64) & (1<<modExpWindow - 1)
+		selectEntry(&entry, &table, window)
+		ctx.mulCT(result, result, &entry)
+	}
+
+	ctx.mulCT(result, result, ONE)
+	return result, nil
+}
+
+// selectEntry sets z = table[index] in constant time by reading every entry.
+func selectEntry(z *Uint512, table *[1 << modExpWindow]Uint512, index uint64) {
+	z.SetZero()
+	for k := range table {
+		mask := -uint64(subtle.ConstantTimeEq(int32(k), int32(index)))
+		for i := range z.words {
+			z.words[i] |= table[k].words[i] & mask
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// modsqrt.go implements modular square roots for Uint512
+package uint512
+
+import "fmt"
+
+// nonResidueTrials bounds the search for a quadratic non-residue in Tonelli-Shanks.
+// The least non-residue of a prime is known to be far smaller for every prime
+// that has been examined, so reaching the bound means the modulus is composite.
+const nonResidueTrials = 1 << 16
+
+// ModSqrt computes a square root of a modulo the odd prime p: result^2 ≡ a (mod p).
+// Of the two roots r and p - r, the smaller one is returned.
+// Uses the Tonelli-Shanks algorithm, which reduces to a single exponentiation
+// when p ≡ 3 (mod 4). p is not tested for primality up front, but the search
+// for a non-residue is bounded and the root is verified, so a composite p
+// returns an error instead of a wrong root or an endless search.
+// Returns an error if p is even (including 2) or zero, if a is a quadratic
+// non-residue modulo p, or if p turns out not to be prime.
+func (u *Uint512) ModSqrt(p *Uint512) (*Uint512, error) {
+	if p.IsZero() || p.IsEven() {
+		return nil, fmt.Errorf("modulus must be an odd prime")
+	}
+
+	ctx, _ := NewMontgomeryContext(p)
+	a, _ := u.Mod(p)
+	if a.IsZero() {
+		return ZERO.Clone(), nil
+	}
+
+	// Euler's criterion: a^((p-1)/2) is 1 for residues and p-1 for non-residues
+	am := ctx.Enter(a)
+	pMinus1 := p.Sub(ONE)
+	half := pMinus1.Shr(1)
+	if !ctx.ExpMont(am, half).Equal(&ctx.r) {
+		return nil, fmt.Errorf("%s is not a quadratic residue modulo %s", u, p)
+	}
+
+	// p - 1 = q * 2^s with q odd
+	s := pMinus1.TrailingZeros()
+	q := pMinus1.Shr(uint(s))
+
+	var root *Uint512
+	if s == 1 {
+		// p ≡ 3 (mod 4): a^((p+1)/4) is a root
+		root = ctx.ExpMont(am, half.Add(ONE).Shr(1))
+	} else {
+		var err error
+		if root, err = ctx.tonelliShanks(am, q, s); err != nil {
+			return nil, err
+		}
+	}
+
+	// Only a prime modulus guarantees that the root is correct
+	check := root.Clone()
+	ctx.mul(check, check, check)
+	if !check.Equal(am) {
+		return nil, fmt.Errorf("modulus %s is not prime", p)
+	}
+
+	root = ctx.Exit(root)
+	if other := p.Sub(root); other.Less(root) {
+		root = other
+	}
+	return root, nil
+}
+
+// tonelliShanks runs the Tonelli-Shanks iteration for a quadratic residue a in
+// Montgomery form, where p - 1 = q * 2^s. The result is in Montgomery form.
+// Returns an error when an invariant that holds for every prime p fails.
+func (ctx *MontgomeryContext) tonelliShanks(a, q *Uint512, s int) (*Uint512, error) {
+	one := &ctx.r
+	minusOne := ctx.mod.Sub(one)
+	half := ctx.mod.Shr(1)
+
+	// Find a non-residue z by trial; half of all values qualify. For a prime p
+	// every z^((p-1)/2) is ±1 and the least non-residue is tiny, so any other
+	// value, running out of candidates or exceeding the trial bound means that
+	// p is composite
+	z := New(2)
+	for {
+		if z.words[0] > nonResidueTrials || !z.Less(&ctx.mod) {
+			return nil, fmt.Errorf("modulus %s is not prime", ctx.mod.String())
+		}
+		e := ctx.ExpMont(ctx.Enter(z), half)
+		if e.Equal(minusOne) {
+			break
+		}
+		if !e.Equal(one) {
+			return nil, fmt.Errorf("modulus %s is not prime", ctx.mod.String())
+		}
+		z.AddSmallInPlace(1)
+	}
+
+	// Invariants: r^2 = a*t, t^(2^(m-1)) = 1 and c is a primitive 2^m-th root of unity
+	m := s
+	c := ctx.ExpMont(ctx.Enter(z), q)
+	t := ctx.ExpMont(a, q)
+	r := ctx.ExpMont(a, q.Add(ONE).Shr(1))
+
+	for !t.Equal(one) {
+		// Find the least i with t^(2^i) = 1; 0 < i < m
+		i := 0
+		for t2 := t.Clone(); !t2.Equal(one); i++ {
+			if i == m {
+				return nil, fmt.Errorf("modulus %s is not prime", ctx.mod.String())
+			}
+			ctx.mul(t2, t2, t2)
+		}
+
+		// b = c^(2^(m-i-1))
+		b := c.Clone()
+		for j := 0; j < m-i-1; j++ {
+			ctx.mul(b, b, b)
+		}
+
+		m = i
+		ctx.mul(c, b, b)
+		ctx.mul(t, t, c)
+		ctx.mul(r, r, b)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,99 @@
+package uint512
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestModSqrt tests modular square roots against math/big for primes with
+// different powers of two in p - 1
+func TestModSqrt(t *testing.T) {
+	for _, p := range []*Uint512{New(2), New(10), ZERO} {
+		if _, err := New(4).ModSqrt(p); err == nil {
+			t.Errorf("ModSqrt(4, %s): expected error", p)
+		}
+	}
+
+	primes := []struct{ name, dec string }{
+		{"2^127 - 1", "170141183460469231731687303715884105727"},                                        // p ≡ 3 (mod 4)
+		{"2^255 - 19", "57896044618658097711785492504343953926634992332820282019728792003956564819949"}, // p - 1 = q * 2^2
+		{"P-224", "26959946667150639794667015087019630673557916260026308143510066298881"},               // p - 1 = q * 2^96
+		{"secp256k1", "115792089237316195423570985008687907853269984665640564039457584007908834671663"}, // p ≡ 3 (mod 4)
+		{"1000000007", "1000000007"},
+		{"65537 (Fermat prime)", "65537"}, // p - 1 = 2^16
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, tc := range primes {
+		pBig, _ := new(big.Int).SetString(tc.dec, 10)
+		p := fromBig(pBig)
+
+		// Known roots: the square of x has roots x and p - x
+		for _, x := range []*Uint512{ZERO, ONE, New(2), New(12345), p.Sub(ONE)} {
+			sq := x.MulMod(x, p)
+			got, err := sq.ModSqrt(p)
+			if err != nil {
+				t.Errorf("%s: ModSqrt(%s): unexpected error %v", tc.name, sq, err)
+				continue
+			}
+			if !got.Equal(x) && !got.Equal(p.Sub(x)) {
+				t.Errorf("%s: ModSqrt(%s^2) = %s, want %s or p - %s", tc.name, x, got, x, x)
+			}
+		}
+
+		for i := 0; i < 30; i++ {
+			a := randUint512(r)
+			aBig := new(big.Int).Mod(toBig(a), pBig)
+			want := new(big.Int).ModSqrt(aBig, pBig)
+
+			got, err := a.ModSqrt(p)
+			if want == nil {
+				if err == nil {
+					t.Errorf("%s: ModSqrt(%s) = %s, expected non-residue error", tc.name, a, got)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s: ModSqrt(%s): unexpected error %v", tc.name, a, err)
+				continue
+			}
+
+			// Compare against the smaller of big's two roots
+			if other := new(big.Int).Sub(pBig, want); other.Cmp(want) < 0 {
+				want = other
+			}
+			if toBig(got).Cmp(want) != 0 {
+				t.Errorf("%s: ModSqrt(%s) = %s, want %s", tc.name, a, got, want)
+			}
+		}
+	}
+}
+
+// TestModSqrtComposite checks that composite moduli, for which no non-residue
+// gives -1 and Tonelli-Shanks has no valid answer, fail instead of searching forever
+func TestModSqrtComposite(t *testing.T) {
+	// The non-residue search itself exposes these: 9 and the Carmichael numbers
+	// 561 and 1729 are all ≡ 1 (mod 8)
+	for _, p := range []uint64{9, 561, 1729} {
+		if got, err := ONE.ModSqrt(New(p)); err == nil {
+			t.Errorf("ModSqrt(1, %d) = %s, expected not-prime error", p, got)
+		}
+	}
+
+	// Otherwise any root that is returned must be correct
+	m127 := ONE.Shl(127).Sub(ONE)
+	m61 := ONE.Shl(61).Sub(ONE)
+	composites := []*Uint512{New(15), New(697), New(9 * 17), m127.mulLow(m61)}
+	r := rand.New(rand.NewSource(1))
+	for _, p := range composites {
+		for i := 0; i < 20; i++ {
+			x := randUint512(r)
+			sq := x.MulMod(x, p)
+			got, err := sq.ModSqrt(p)
+			if err == nil && !got.MulMod(got, p).Equal(sq) {
+				t.Errorf("ModSqrt(%s, %s) = %s, which is not a root", sq, p, got)
+			}
+		}
+	}
+}
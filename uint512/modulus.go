@@ -0,0 +1,93 @@
+// modulus.go implements a modulus type that picks a reduction strategy for Uint512
+package uint512
+
+import "fmt"
+
+// Modulus bundles a fixed modulus m with the precomputations for its fastest
+// reduction strategy: Montgomery multiplication for odd moduli and Barrett
+// reduction for even ones. All methods accept operands of any size, reducing
+// them first, and return values below m.
+// A Modulus is never modified after construction, so it is safe for concurrent use.
+type Modulus struct {
+	// mod is the modulus m
+	mod Uint512
+	// mont holds the Montgomery constants; nil when m is even
+	mont *MontgomeryContext
+	// barrett holds the Barrett reciprocal; nil when m is odd
+	barrett *BarrettReducer
+}
+
+// NewModulus inspects mod and precomputes the constants for its reduction strategy.
+// Returns an error if mod is zero.
+func NewModulus(mod *Uint512) (*Modulus, error) {
+	if mod.IsZero() {
+		return nil, fmt.Errorf("division by zero")
+	}
+
+	m := &Modulus{mod: *mod}
+	if mod.IsOdd() {
+		m.mont, _ = NewMontgomeryContext(mod)
+	} else {
+		m.barrett = NewBarrettReducer(mod)
+	}
+	return m, nil
+}
+
+// Modulus returns a copy of the modulus m.
+func (m *Modulus) Modulus() *Uint512 {
+	return m.mod.Clone()
+}
+
+// Reduce computes x % m.
+func (m *Modulus) Reduce(x *Uint512) *Uint512 {
+	return x.reduce(&m.mod).Clone()
+}
+
+// Add performs modular addition: result = (a + b) % m.
+func (m *Modulus) Add(a, b *Uint512) *Uint512 {
+	return a.reduce(&m.mod).AddMod(b.reduce(&m.mod), &m.mod)
+}
+
+// Sub performs modular subtraction: result = (a - b) % m.
+func (m *Modulus) Sub(a, b *Uint512) *Uint512 {
+	return a.reduce(&m.mod).SubMod(b.reduce(&m.mod), &m.mod)
+}
+
+// Mul performs modular multiplication: result = (a * b) % m.
+// For odd m two Montgomery multiplications suffice, since multiplying
+// a*b*R^-1 by R^2 in Montgomery form gives a*b; for even m the 1024-bit
+// product is reduced with Barrett reduction.
+func (m *Modulus) Mul(a, b *Uint512) *Uint512 {
+	if m.mont == nil {
+		return m.barrett.Reduce(a.Mul(b))
+	}
+
+	result := &Uint512{}
+	m.mont.mul(result, a.reduce(&m.mod), b.reduce(&m.mod))
+	m.mont.mul(result, result, &m.mont.r2)
+	return result
+}
+
+// Exp performs modular exponentiation: result = a^exp % m.
+// Odd moduli stay in Montgomery form throughout.
+func (m *Modulus) Exp(a, exp *Uint512) *Uint512 {
+	if m.mont != nil {
+		return m.mont.exp(a, exp)
+	}
+
+	base := m.Reduce(a)
+	result := m.Reduce(ONE)
+	for i := 512 - exp.LeadingZeros() - 1; i >= 0; i-- {
+		result = m.barrett.Reduce(result.Square())
+		if exp.Bit(i) {
+			result = m.barrett.Reduce(result.Mul(base))
+		}
+	}
+	return result
+}
+
+// Inv computes the modular inverse: result = x with (a * x) % m == 1.
+// Returns ErrNotInvertible if gcd(a, m) != 1 or m is one.
+func (m *Modulus) Inv(a *Uint512) (*Uint512, error) {
+	return a.modInverse(&m.mod)
+}
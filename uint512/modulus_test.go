@@ -0,0 +1,84 @@
+package uint512
+
+import (
+	"errors"
+	"math/big"
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+// TestModulus cross-checks every operation against math/big for odd and even moduli
+func TestModulus(t *testing.T) {
+	if _, err := NewModulus(ZERO); err == nil {
+		t.Error("NewModulus(0): expected error")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	moduli := []*Uint512{ONE, New(2), New(3), ONE.Shl(511), MAX, MAX.Sub(ONE), New(1000000007)}
+	for i := 0; i < 20; i++ {
+		moduli = append(moduli, randUint512(r).Shr(uint(r.Intn(512))).Add(ONE))
+	}
+
+	for _, mod := range moduli {
+		m, err := NewModulus(mod)
+		if err != nil {
+			t.Fatalf("NewModulus(%s): unexpected error %v", mod, err)
+		}
+		if !m.Modulus().Equal(mod) {
+			t.Errorf("Modulus() = %s, want %s", m.Modulus(), mod)
+		}
+		mBig := toBig(mod)
+
+		for i := 0; i < 10; i++ {
+			a, b := randUint512(r), randUint512(r)
+			aBig, bBig := toBig(a), toBig(b)
+
+			check := func(name string, got *Uint512, want *big.Int) {
+				t.Helper()
+				want.Mod(want, mBig)
+				if toBig(got).Cmp(want) != 0 {
+					t.Errorf("%s(%s, %s) mod %s = %s, want %s", name, a, b, mod, got, want)
+				}
+			}
+			check("Reduce", m.Reduce(a), new(big.Int).Set(aBig))
+			check("Add", m.Add(a, b), new(big.Int).Add(aBig, bBig))
+			check("Sub", m.Sub(a, b), new(big.Int).Sub(aBig, bBig))
+			check("Mul", m.Mul(a, b), new(big.Int).Mul(aBig, bBig))
+			check("Exp", m.Exp(a, b), new(big.Int).Exp(aBig, bBig, mBig))
+
+			inv, err := m.Inv(a)
+			if want := new(big.Int).ModInverse(aBig, mBig); want == nil || mod.Equal(ONE) {
+				if !errors.Is(err, ErrNotInvertible) {
+					t.Errorf("Inv(%s) mod %s: got (%v, %v), want ErrNotInvertible", a, mod, inv, err)
+				}
+			} else if err != nil || toBig(inv).Cmp(want) != 0 {
+				t.Errorf("Inv(%s) mod %s = (%v, %v), want %s", a, mod, inv, err, want)
+			}
+		}
+	}
+}
+
+// TestModulusConcurrent uses one Modulus from several goroutines
+func TestModulusConcurrent(t *testing.T) {
+	for _, mod := range []*Uint512{MAX, MAX.Sub(ONE)} {
+		m, _ := NewModulus(mod)
+		a, b := MAX.Sub(New(12345)), MAX.Sub(New(67890))
+		want := m.Exp(a, b)
+
+		var wg sync.WaitGroup
+		for g := 0; g < 8; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 20; i++ {
+					if got := m.Exp(a, b); !got.Equal(want) {
+						t.Errorf("concurrent Exp = %s, want %s", got, want)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+	}
+}
@@ -0,0 +1,200 @@
+// montgomery.go implements Montgomery multiplication for Uint512
+package uint512
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// MontgomeryContext holds the values precomputed for Montgomery arithmetic modulo
+// a fixed odd modulus m, with R = 2^512. A value x is represented in Montgomery
+// form as x*R mod m, in which modular multiplication needs no division.
+// Montgomery, ToMont, FromMont and MulMont are alternative names for
+// MontgomeryContext, Enter, Exit and Mul.
+type MontgomeryContext struct {
+	// mod is the odd modulus m
+	mod Uint512
+	// modInv is -m^-1 mod 2^64, used to clear one low word per reduction step
+	modInv uint64
+	// r is R mod m, the Montgomery form of one
+	r Uint512
+	// r2 is R^2 mod m, used to convert values into Montgomery form
+	r2 Uint512
+}
+
+// Montgomery is an alias of MontgomeryContext.
+type Montgomery = MontgomeryContext
+
+// NewMontgomeryContext precomputes the Montgomery constants for mod.
+// Returns an error if mod is zero or even.
+func NewMontgomeryContext(mod *Uint512) (*MontgomeryContext, error) {
+	if mod.IsZero() {
+		return nil, fmt.Errorf("montgomery modulus must be non-zero")
+	}
+	if mod.words[0]&1 == 0 {
+		return nil, fmt.Errorf("montgomery modulus must be odd")
+	}
+
+	// Newton's iteration inv = inv * (2 - m*inv) doubles the number of correct low
+	// bits; an odd m is its own inverse modulo 8, so five steps reach 64 bits
+	inv := mod.words[0]
+	for i := 0; i < 5; i++ {
+		inv *= 2 - mod.words[0]*inv
+	}
+
+	ctx := &MontgomeryContext{mod: *mod, modInv: -inv}
+
+	// R mod m from the 1024-bit value 2^512, then R^2 mod m = (R mod m)^2 mod m
+	var wide [16]uint64
+	wide[8] = 1
+	ctx.r = *reduceWide(&wide, mod)
+	ctx.r2 = *ctx.r.mulMod(&ctx.r, mod)
+
+	return ctx, nil
+}
+
+// NewMontgomery is an alias of NewMontgomeryContext.
+func NewMontgomery(mod *Uint512) (*Montgomery, error) {
+	return NewMontgomeryContext(mod)
+}
+
+// Modulus returns a copy of the modulus of the context.
+func (ctx *MontgomeryContext) Modulus() *Uint512 {
+	return ctx.mod.Clone()
+}
+
+// Enter converts x into Montgomery form: result = x*R mod m.
+// x is reduced modulo m first, so it may be any value.
+func (ctx *MontgomeryContext) Enter(x *Uint512) *Uint512 {
+	reduced, _ := x.Mod(&ctx.mod)
+	return ctx.Mul(reduced, &ctx.r2)
+}
+
+// Exit converts x out of Montgomery form: result = x*R^-1 mod m.
+func (ctx *MontgomeryContext) Exit(x *Uint512) *Uint512 {
+	return ctx.Mul(x, ONE)
+}
+
+// Mul performs Montgomery multiplication: result = a*b*R^-1 mod m.
+// For operands in Montgomery form the result is the Montgomery form of their
+// product. Both operands must be less than m.
+func (ctx *MontgomeryContext) Mul(a, b *Uint512) *Uint512 {
+	result := &Uint512{}
+	ctx.mul(result, a, b)
+	return result
+}
+
+// ToMont is an alias of Enter.
+func (ctx *MontgomeryContext) ToMont(x *Uint512) *Uint512 {
+	return ctx.Enter(x)
+}
+
+// FromMont is an alias of Exit.
+func (ctx *MontgomeryContext) FromMont(x *Uint512) *Uint512 {
+	return ctx.Exit(x)
+}
+
+// MulMont is an alias of Mul.
+func (ctx *MontgomeryContext) MulMont(a, b *Uint512) *Uint512 {
+	return ctx.Mul(a, b)
+}
+
+// ExpMont performs exponentiation in Montgomery form: result = x^exp*R^(1-exp) mod m.
+// For x in Montgomery form the result is the Montgomery form of x^exp; an
+// exponent of zero gives R mod m, the Montgomery form of one.
+// x must be less than m.
+func (ctx *MontgomeryContext) ExpMont(x, exp *Uint512) *Uint512 {
+	result := ctx.r.Clone()
+
+	for i := 512 - exp.LeadingZeros() - 1; i >= 0; i-- {
+		ctx.mul(result, result, result)
+		if exp.Bit(i) {
+			ctx.mul(result, result, x)
+		}
+	}
+
+	return result
+}
+
+// MontgomeryMul performs Montgomery multiplication with ctx: result = a*b*R^-1 mod m.
+// Same as ctx.Mul(a, b).
+func MontgomeryMul(a, b *Uint512, ctx *MontgomeryContext) *Uint512 {
+	return ctx.Mul(a, b)
+}
+
+// mul computes z = a*b*R^-1 mod m. z may alias a or b.
+func (ctx *MontgomeryContext) mul(z, a, b *Uint512) {
+	var t [10]uint64
+	ctx.product(&t, a, b)
+
+	// The result is below 2m, so one conditional subtraction reduces it
+	copy(z.words[:], t[:8])
+	if t[8] != 0 || !z.Less(&ctx.mod) {
+		z.SubInPlace(&ctx.mod)
+	}
+}
+
+// mulCT is mul with the final subtraction done by masking instead of branching,
+// so its running time does not depend on the values of a and b.
+func (ctx *MontgomeryContext) mulCT(z, a, b *Uint512) {
+	var t [10]uint64
+	ctx.product(&t, a, b)
+
+	// Keep t only if t - m borrows out of the nine words, that is, if t < m
+	var d [8]uint64
+	var borrow uint64
+	for i := range d {
+		d[i], borrow = bits.Sub64(t[i], ctx.mod.words[i], borrow)
+	}
+	_, borrow = bits.Sub64(t[8], 0, borrow)
+
+	keep := -borrow
+	for i := range z.words {
+		z.words[i] = t[i]&keep | d[i]&^keep
+	}
+}
+
+// product computes a*b*R^-1 with the CIOS method, leaving a value below 2m in
+// the low nine words of t: each word of b is multiplied in and one word of the
+// running sum is cleared by adding a multiple of m, so the intermediate never
+// exceeds 10 words. The loops do not depend on the operand values.
+func (ctx *MontgomeryContext) product(t *[10]uint64, a, b *Uint512) {
+	n := &ctx.mod.words
+
+	for i := range b.words {
+		// t += a * b[i]
+		var carry uint64
+		for j := range a.words {
+			hi, lo := bits.Mul64(a.words[j], b.words[i])
+
+			var c uint64
+			lo, c = bits.Add64(lo, t[j], 0)
+			hi += c
+			t[j], c = bits.Add64(lo, carry, 0)
+			carry = hi + c
+		}
+		var c uint64
+		t[8], c = bits.Add64(t[8], carry, 0)
+		t[9] = c
+
+		// t = (t + q*m) / 2^64, where q makes the low word of the sum zero
+		q := t[0] * ctx.modInv
+		hi, lo := bits.Mul64(q, n[0])
+		_, c = bits.Add64(lo, t[0], 0)
+		carry = hi + c
+		for j := 1; j < len(n); j++ {
+			hi, lo := bits.Mul64(q, n[j])
+			lo, c = bits.Add64(lo, t[j], 0)
+			hi += c
+			t[j-1], c = bits.Add64(lo, carry, 0)
+			carry = hi + c
+		}
+		t[7], c = bits.Add64(t[8], carry, 0)
+		t[8] = t[9] + c
+	}
+}
+
+// exp computes base^exp mod m by square-and-multiply in Montgomery form.
+func (ctx *MontgomeryContext) exp(base, exp *Uint512) *Uint512 {
+	return ctx.Exit(ctx.ExpMont(ctx.Enter(base), exp))
+}
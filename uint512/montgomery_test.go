@@ -0,0 +1,160 @@
+package uint512
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// TestMontgomeryContext tests Montgomery conversion and multiplication against math/big
+func TestMontgomeryContext(t *testing.T) {
+	if _, err := NewMontgomeryContext(ZERO); err == nil {
+		t.Error("NewMontgomeryContext(0): expected error")
+	}
+	if _, err := NewMontgomeryContext(New(1000)); err == nil {
+		t.Error("NewMontgomeryContext(1000): expected error for even modulus")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	moduli := []*Uint512{ONE, New(3), New(1000000007), MAX, ONE.Shl(511).Add(ONE)}
+	for i := 0; i < 20; i++ {
+		m := randUint512(r)
+		m.words[0] |= 1
+		moduli = append(moduli, m)
+	}
+
+	for _, m := range moduli {
+		ctx, err := NewMontgomeryContext(m)
+		if err != nil {
+			t.Fatalf("NewMontgomeryContext(%s): unexpected error %v", m, err)
+		}
+		if !ctx.Modulus().Equal(m) {
+			t.Errorf("Modulus() = %s, want %s", ctx.Modulus(), m)
+		}
+
+		for i := 0; i < 20; i++ {
+			a, b := randUint512(r), randUint512(r)
+			want := new(big.Int).Mul(toBig(a), toBig(b))
+			want.Mod(want, toBig(m))
+
+			am, bm := ctx.Enter(a), ctx.Enter(b)
+			if got := ctx.Exit(am); toBig(got).Cmp(new(big.Int).Mod(toBig(a), toBig(m))) != 0 {
+				t.Errorf("Exit(Enter(%s)) mod %s = %s", a, m, got)
+			}
+			if got := ctx.Exit(ctx.Mul(am, bm)); toBig(got).Cmp(want) != 0 {
+				t.Errorf("Montgomery %s * %s mod %s = %s, want %s", a, b, m, got, want)
+			}
+			if got := MontgomeryMul(am, bm, ctx); !got.Equal(ctx.Mul(am, bm)) {
+				t.Errorf("MontgomeryMul(%s, %s) = %s, want %s", am, bm, got, ctx.Mul(am, bm))
+			}
+		}
+	}
+}
+
+// TestMontgomeryAliases tests the ToMont, FromMont, MulMont and ExpMont names
+// against the plain ModMul and math/big
+func TestMontgomeryAliases(t *testing.T) {
+	if _, err := NewMontgomery(New(1000)); err == nil {
+		t.Error("NewMontgomery(1000): expected error for even modulus")
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		m := randUint512(r)
+		m.words[0] |= 1
+		mont, err := NewMontgomery(m)
+		if err != nil {
+			t.Fatalf("NewMontgomery(%s): unexpected error %v", m, err)
+		}
+
+		a, b, e := randUint512(r), randUint512(r), randUint512(r)
+		am, bm := mont.ToMont(a), mont.ToMont(b)
+
+		want, _ := a.ModMul(b, m)
+		if got := mont.FromMont(mont.MulMont(am, bm)); !got.Equal(want) {
+			t.Errorf("MulMont: %s * %s mod %s = %s, want %s", a, b, m, got, want)
+		}
+
+		wantExp := new(big.Int).Exp(toBig(a), toBig(e), toBig(m))
+		if got := mont.FromMont(mont.ExpMont(am, e)); toBig(got).Cmp(wantExp) != 0 {
+			t.Errorf("ExpMont: %s^%s mod %s = %s, want %s", a, e, m, got, wantExp)
+		}
+		if got := mont.FromMont(mont.ExpMont(am, ZERO)); toBig(got).Cmp(new(big.Int).Mod(big.NewInt(1), toBig(m))) != 0 {
+			t.Errorf("ExpMont: %s^0 mod %s = %s, want 1", a, m, got)
+		}
+	}
+}
+
+// TestPowModMontgomery tests PowMod with odd moduli, which use Montgomery multiplication
+func TestPowModMontgomery(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		base, m := randUint512(r), randUint512(r)
+		m.words[0] |= 1
+		exp := uint(r.Uint64())
+
+		want := new(big.Int).Exp(toBig(base), new(big.Int).SetUint64(uint64(exp)), toBig(m))
+		got, err := base.PowMod(exp, m)
+		if err != nil {
+			t.Fatalf("PowMod: unexpected error %v", err)
+		}
+		if toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s^%d mod %s = %s, want %s", base, exp, m, got, want)
+		}
+	}
+}
+
+// BenchmarkMontgomeryMul compares one Montgomery multiplication with MulMod,
+// which reduces the full product by division
+func BenchmarkMontgomeryMul(b *testing.B) {
+	mod := MAX.Sub(New(188)) // 2^512 - 189 is odd
+	x, y := MAX.Sub(New(12345)), MAX.Sub(New(67890))
+	ctx, _ := NewMontgomeryContext(mod)
+	xm, ym := ctx.Enter(x), ctx.Enter(y)
+
+	b.Run("MontgomeryMul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			MontgomeryMul(xm, ym, ctx)
+		}
+	})
+	b.Run("MulMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.MulMod(y, mod)
+		}
+	})
+}
+
+// BenchmarkExpMont compares exponentiation with a full-width exponent in
+// Montgomery form against square-and-multiply with ModMul
+func BenchmarkExpMont(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	mod := MAX.Sub(New(188)) // 2^512 - 189 is odd
+	x, exp := randUint512(r), randUint512(r)
+	mont, _ := NewMontgomery(mod)
+
+	b.Run("ExpMont", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			mont.FromMont(mont.ExpMont(mont.ToMont(x), exp))
+		}
+	})
+	b.Run("ModMul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			result := ONE.Clone()
+			for j := 511; j >= 0; j-- {
+				result, _ = result.ModMul(result, mod)
+				if exp.Bit(j) {
+					result, _ = result.ModMul(x, mod)
+				}
+			}
+		}
+	})
+}
+
+// BenchmarkPowMod measures modular exponentiation with a full-width odd modulus
+func BenchmarkPowMod(b *testing.B) {
+	base := MAX.Sub(New(12345))
+	mod := MAX.Sub(New(188)) // 2^512 - 189 is odd
+	for i := 0; i < b.N; i++ {
+		base.PowMod(^uint(0), mod)
+	}
+}
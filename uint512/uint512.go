@@ -4,7 +4,11 @@ package uint512
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
 	"strings"
 )
 
@@ -29,6 +33,18 @@ var (
 	MAX = &Uint512{words: [8]uint64{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}
 )
 
+// Sentinel errors returned by checked arithmetic; test for them with errors.Is
+var (
+	// ErrOverflow reports a result that does not fit in 512 bits
+	ErrOverflow = errors.New("uint512: overflow")
+
+	// ErrUnderflow reports a result that would be negative
+	ErrUnderflow = errors.New("uint512: underflow")
+
+	// ErrNotInvertible reports a value without a modular inverse
+	ErrNotInvertible = errors.New("uint512: not invertible")
+)
+
 // New creates a new Uint512 from a uint64 value.
 func New(val uint64) *Uint512 {
 	u := &Uint512{}
@@ -121,6 +137,26 @@ func (u *Uint512) Clone() *Uint512 {
 	return result
 }
 
+// Set copies the value of x into the receiver and returns the receiver.
+// Together with the InPlace methods this allows scratch values to be reused
+// across iterations without allocating.
+func (u *Uint512) Set(x *Uint512) *Uint512 {
+	u.words = x.words
+	return u
+}
+
+// SetUint64 sets the receiver to the value of a uint64 and returns the receiver.
+func (u *Uint512) SetUint64(val uint64) *Uint512 {
+	u.words = [8]uint64{val}
+	return u
+}
+
+// SetZero sets the receiver to zero and returns the receiver.
+func (u *Uint512) SetZero() *Uint512 {
+	u.words = [8]uint64{}
+	return u
+}
+
 // IsZero returns true if the value is zero.
 func (u *Uint512) IsZero() bool {
 	return u.Equal(ZERO)
@@ -134,6 +170,73 @@ func (u *Uint512) ToLimbs() []uint64 {
 	return limbs
 }
 
+// Word returns the 64-bit limb at index i, where limb 0 is the least significant,
+// consistent with FromLimbs and ToLimbs.
+// Panics if i is outside [0, 8).
+func (u *Uint512) Word(i int) uint64 {
+	if i < 0 || i >= len(u.words) {
+		panic(fmt.Sprintf("uint512: word index %d out of range [0, %d)", i, len(u.words)))
+	}
+	return u.words[i]
+}
+
+// SetWord sets the 64-bit limb at index i to v, where limb 0 is the least significant.
+// Panics if i is outside [0, 8).
+func (u *Uint512) SetWord(i int, v uint64) {
+	if i < 0 || i >= len(u.words) {
+		panic(fmt.Sprintf("uint512: word index %d out of range [0, %d)", i, len(u.words)))
+	}
+	u.words[i] = v
+}
+
+// NumWords returns the number of 64-bit limbs in a Uint512, which is always 8.
+func (u *Uint512) NumWords() int {
+	return len(u.words)
+}
+
+// Uint64 returns the low 64 bits of the value.
+// If IsUint64 is false the result is truncated.
+func (u *Uint512) Uint64() uint64 {
+	return u.words[0]
+}
+
+// IsUint64 returns true if the value can be represented as a uint64.
+func (u *Uint512) IsUint64() bool {
+	for i := 1; i < len(u.words); i++ {
+		if u.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Float64 returns the float64 value nearest to u, rounding half to even,
+// and whether the result is exact, rounded down (big.Below) or up (big.Above).
+// Every Uint512 value is far below math.MaxFloat64, so the result is always finite.
+func (u *Uint512) Float64() (float64, big.Accuracy) {
+	bitLen := 512 - u.LeadingZeros()
+	if bitLen <= 53 {
+		return float64(u.words[0]), big.Exact
+	}
+
+	// Keep the top 53 bits as the mantissa and round on the bits below them
+	shift := bitLen - 53
+	mantissa := u.Shr(uint(shift)).words[0]
+	half := u.Bit(shift - 1)
+	sticky := u.TrailingZeros() < shift-1
+
+	accuracy := big.Exact
+	if half && (sticky || mantissa&1 == 1) {
+		// A carry out of the mantissa yields 2^53, which is still exactly representable
+		mantissa++
+		accuracy = big.Above
+	} else if half || sticky {
+		accuracy = big.Below
+	}
+
+	return math.Ldexp(float64(mantissa), shift), accuracy
+}
+
 // ToLeBytes returns the Uint512 as a 64-byte slice in little-endian order.
 func (u *Uint512) ToLeBytes() []byte {
 	bytes := make([]byte, 64)
@@ -160,19 +263,56 @@ func (u *Uint512) ToBeBytes() []byte {
 	return bytes
 }
 
+// Key returns the canonical 64-byte big-endian encoding of the value as an array,
+// which is comparable and can be used directly as a map key.
+// The encoding is identical to ToBeBytes and is stable across versions,
+// so keys are safe to persist.
+func (u *Uint512) Key() [64]byte {
+	var key [64]byte
+	for i := range u.words {
+		binary.BigEndian.PutUint64(key[i*8:], u.words[7-i])
+	}
+	return key
+}
+
+// Hash64 returns a fast non-cryptographic 64-bit hash of the value, e.g. for
+// choosing a shard. Different seeds yield independent hash functions.
+// Unlike Key, hash values are not guaranteed to be stable across versions.
+func (u *Uint512) Hash64(seed uint64) uint64 {
+	h := seed ^ 0x9E3779B97F4A7C15
+	for _, word := range u.words {
+		h = hashMix(h^word, 0xBF58476D1CE4E5B9)
+	}
+	return hashMix(h, 0x94D049BB133111EB)
+}
+
+// hashMix folds the 128-bit product of a and b into 64 bits.
+func hashMix(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return hi ^ lo
+}
+
 // String returns the decimal string representation of the number.
 func (u *Uint512) String() string {
 	if u.IsZero() {
 		return "0"
 	}
 
-	// Convert to decimal using repeated division by 10
+	// Convert to decimal nine digits at a time using repeated division by 10^9
 	temp := u.Clone()
 	var digits []byte
 
 	for !temp.IsZero() {
-		remainder := temp.divBySmall(10)
-		digits = append(digits, byte('0'+remainder))
+		chunk := temp.divBySmall(1000000000)
+		for i := 0; i < 9; i++ {
+			digits = append(digits, byte('0'+chunk%10))
+			chunk /= 10
+		}
+	}
+
+	// Drop the leading zeros padded into the most significant chunk
+	for len(digits) > 1 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
 	}
 
 	// Reverse the digits
@@ -216,7 +356,7 @@ func (u *Uint512) Hex() string {
 	return result.String()
 }
 
-// divBySmall divides the number by a small divisor (< 2^64) and returns the remainder.
+// divBySmall divides the number by a small divisor (< 2^32) and returns the remainder.
 // This modifies the receiver in place.
 func (u *Uint512) divBySmall(divisor uint64) uint64 {
 	var remainder uint64
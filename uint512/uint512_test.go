@@ -1,7 +1,12 @@
 package uint512
 
 import (
+	"errors"
+	"math/big"
+	"math/rand"
 	"testing"
+
+	"github.com/Alivers/guint/uint1024"
 )
 
 // TestUint512Creation tests basic creation and initialization of Uint512
@@ -236,6 +241,31 @@ func TestBitOperations(t *testing.T) {
 	}
 }
 
+// TestBitLen tests BitLen and Log2 on both sides of every word boundary
+func TestBitLen(t *testing.T) {
+	if ZERO.BitLen() != 0 {
+		t.Errorf("BitLen(0) = %d, want 0", ZERO.BitLen())
+	}
+	if _, err := ZERO.Log2(); err == nil {
+		t.Error("Log2(0): expected error")
+	}
+	if MAX.BitLen() != 512 {
+		t.Errorf("BitLen(MAX) = %d, want 512", MAX.BitLen())
+	}
+
+	for i := 0; i < 512; i++ {
+		// 2^i and 2^(i+1) - 1 both have bit length i + 1
+		for _, u := range []*Uint512{ONE.Shl(uint(i)), MAX.Shr(uint(511 - i))} {
+			if got := u.BitLen(); got != i+1 {
+				t.Errorf("BitLen(%s) = %d, want %d", u.Hex(), got, i+1)
+			}
+			if got, err := u.Log2(); err != nil || got != uint(i) {
+				t.Errorf("Log2(%s) = (%d, %v), want %d", u.Hex(), got, err, i)
+			}
+		}
+	}
+}
+
 // TestShiftOperations tests shift operations
 func TestShiftOperations(t *testing.T) {
 	// Test left shift
@@ -254,3 +284,1731 @@ func TestShiftOperations(t *testing.T) {
 		t.Errorf("16 >> 4: got %s, want %s", result.String(), expected.String())
 	}
 }
+
+// TestRotate tests rotations against the shift-and-or definition
+func TestRotate(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []uint{0, 1, 5, 63, 64, 65, 128, 255, 256, 448, 511, 512, 513, 1000, 1024} {
+		u := randUint512(r)
+		k := n % 512
+		want := u.Shl(k).Or(u.Shr(512 - k))
+		if k == 0 {
+			want = u.Clone()
+		}
+
+		if got := u.RotateLeft(n); !got.Equal(want) {
+			t.Errorf("RotateLeft(%s, %d) = %s, want %s", u.Hex(), n, got.Hex(), want.Hex())
+		}
+		if got := want.RotateRight(n); !got.Equal(u) {
+			t.Errorf("RotateRight(%s, %d) = %s, want %s", want.Hex(), n, got.Hex(), u.Hex())
+		}
+
+		inPlace := u.Clone()
+		inPlace.RotateLeftInPlace(n)
+		if !inPlace.Equal(want) {
+			t.Errorf("RotateLeftInPlace(%s, %d) = %s, want %s", u.Hex(), n, inPlace.Hex(), want.Hex())
+		}
+		inPlace.RotateRightInPlace(n)
+		if !inPlace.Equal(u) {
+			t.Errorf("RotateRightInPlace(%d) did not undo RotateLeftInPlace(%d): got %s, want %s", n, n, inPlace.Hex(), u.Hex())
+		}
+	}
+
+	// The top bit wraps around to the bottom and back
+	if got := ONE.Shl(511).RotateLeft(1); !got.Equal(ONE) {
+		t.Errorf("RotateLeft(2^511, 1) = %s, want 1", got.Hex())
+	}
+	if got := ONE.RotateRight(1); !got.Equal(ONE.Shl(511)) {
+		t.Errorf("RotateRight(1, 1) = %s, want 2^511", got.Hex())
+	}
+}
+
+// TestReverseBytes tests byte reversal against the byte-slice conversions
+func TestReverseBytes(t *testing.T) {
+	if got := ONE.ReverseBytes(); !got.Equal(ONE.Shl(504)) {
+		t.Errorf("ReverseBytes(1) = %s, want 2^504", got.Hex())
+	}
+	if got := MAX.ReverseBytes(); !got.Equal(MAX) {
+		t.Errorf("ReverseBytes(MAX) = %s, want MAX", got.Hex())
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		u := randUint512(r)
+		got := u.ReverseBytes()
+		if want := FromBeBytes(u.ToLeBytes()); !got.Equal(want) {
+			t.Errorf("ReverseBytes(%s) = %s, want %s", u.Hex(), got.Hex(), want.Hex())
+		}
+		if !got.ReverseBytes().Equal(u) {
+			t.Errorf("ReverseBytes(ReverseBytes(%s)) = %s", u.Hex(), got.ReverseBytes().Hex())
+		}
+	}
+}
+
+// TestReverseBits tests bit reversal against a bit-by-bit reference
+func TestReverseBits(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		u := randUint512(r)
+		got := u.ReverseBits()
+		for j := 0; j < 512; j++ {
+			if got.Bit(j) != u.Bit(511-j) {
+				t.Fatalf("ReverseBits(%s) = %s: bit %d differs from bit %d of the input", u.Hex(), got.Hex(), j, 511-j)
+			}
+		}
+		if !got.ReverseBits().Equal(u) {
+			t.Errorf("ReverseBits(ReverseBits(%s)) = %s", u.Hex(), got.ReverseBits().Hex())
+		}
+	}
+}
+
+// TestTruncateToBits tests masking a value to a given bit width
+func TestTruncateToBits(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        uint
+		expected *Uint512
+	}{
+		{"Zero width", 0, ZERO.Clone()},
+		{"Single bit", 1, New(1)},
+		{"Within first word", 8, New(0xff)},
+		{"Word boundary", 64, FromLimbs([]uint64{^uint64(0)})},
+		{"384 bits", 384, FromLimbs([]uint64{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)})},
+		{"448 bits", 448, FromLimbs([]uint64{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)})},
+		{"Full width", 512, MAX.Clone()},
+		{"Beyond full width", 1000, MAX.Clone()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MAX.TruncateToBits(tt.n)
+			if !result.Equal(tt.expected) {
+				t.Errorf("MAX.TruncateToBits(%d) = %s, want %s", tt.n, result.Hex(), tt.expected.Hex())
+			}
+			if !result.FitsBits(tt.n) {
+				t.Errorf("MAX.TruncateToBits(%d) should fit in %d bits", tt.n, tt.n)
+			}
+
+			inPlace := MAX.Clone()
+			inPlace.TruncateToBitsInPlace(tt.n)
+			if !inPlace.Equal(tt.expected) {
+				t.Errorf("TruncateToBitsInPlace(%d) = %s, want %s", tt.n, inPlace.Hex(), tt.expected.Hex())
+			}
+		})
+	}
+
+	// The receiver must not be modified
+	if !MAX.Equal(&Uint512{words: [8]uint64{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}) {
+		t.Error("TruncateToBits should not modify the receiver")
+	}
+}
+
+// TestFitsBits tests bit width checks
+func TestFitsBits(t *testing.T) {
+	u := ONE.Shl(383) // highest set bit is 383
+
+	if !u.FitsBits(384) {
+		t.Error("2^383 should fit in 384 bits")
+	}
+	if u.FitsBits(383) {
+		t.Error("2^383 should not fit in 383 bits")
+	}
+	if !ZERO.FitsBits(0) {
+		t.Error("Zero should fit in 0 bits")
+	}
+	if ONE.FitsBits(0) {
+		t.Error("One should not fit in 0 bits")
+	}
+	if !MAX.FitsBits(512) || MAX.FitsBits(511) {
+		t.Error("MAX should fit in exactly 512 bits")
+	}
+}
+
+// TestAbsDiff tests the absolute difference
+func TestAbsDiff(t *testing.T) {
+	a, b := New(100), New(30)
+	if !a.AbsDiff(b).Equal(New(70)) || !b.AbsDiff(a).Equal(New(70)) {
+		t.Error("|100 - 30| should be 70 in both operand orders")
+	}
+	if !a.AbsDiff(a.Clone()).IsZero() {
+		t.Error("|a - a| should be 0")
+	}
+	if !ZERO.AbsDiff(MAX).Equal(MAX) || !MAX.AbsDiff(ZERO).Equal(MAX) {
+		t.Error("|0 - MAX| should be MAX")
+	}
+}
+
+// TestNeg tests two's complement negation
+func TestNeg(t *testing.T) {
+	if !ZERO.Neg().IsZero() {
+		t.Errorf("Neg(0) = %s, want 0", ZERO.Neg().Hex())
+	}
+	if !ONE.Neg().Equal(MAX) || !MAX.Neg().Equal(ONE) {
+		t.Error("Neg(1) should be MAX and Neg(MAX) should be 1")
+	}
+	// 2^511 is its own negation
+	if half := ONE.Shl(511); !half.Neg().Equal(half) {
+		t.Errorf("Neg(2^511) = %s, want itself", half.Neg().Hex())
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a, b := randUint512(r), randUint512(r)
+		if !a.Neg().Equal(a.Not().Add(ONE)) {
+			t.Errorf("Neg(%s) = %s, want ^a + 1", a.Hex(), a.Neg().Hex())
+		}
+		if !a.Add(a.Neg()).IsZero() || !a.Neg().Neg().Equal(a) {
+			t.Errorf("Neg(%s) = %s is not the additive inverse", a.Hex(), a.Neg().Hex())
+		}
+		if !a.Add(b.Neg()).Equal(a.Sub(b)) {
+			t.Errorf("%s + Neg(%s) = %s, want %s", a.Hex(), b.Hex(), a.Add(b.Neg()).Hex(), a.Sub(b).Hex())
+		}
+	}
+}
+
+// TestAvg tests the overflow-free averages against math/big
+func TestAvg(t *testing.T) {
+	check := func(a, b *Uint512) {
+		t.Helper()
+		sum := new(big.Int).Add(toBig(a), toBig(b))
+		wantFloor := new(big.Int).Rsh(sum, 1)
+		wantCeil := new(big.Int).Rsh(sum.Add(sum, big.NewInt(1)), 1)
+
+		if got := a.AvgFloor(b); toBig(got).Cmp(wantFloor) != 0 {
+			t.Errorf("AvgFloor(%s, %s) = %s, want %s", a.Hex(), b.Hex(), got.Hex(), wantFloor.Text(16))
+		}
+		if got := a.AvgCeil(b); toBig(got).Cmp(wantCeil) != 0 {
+			t.Errorf("AvgCeil(%s, %s) = %s, want %s", a.Hex(), b.Hex(), got.Hex(), wantCeil.Text(16))
+		}
+		if !a.AvgFloor(b).Equal(b.AvgFloor(a)) || !a.AvgCeil(b).Equal(b.AvgCeil(a)) {
+			t.Errorf("averages of %s and %s depend on the operand order", a.Hex(), b.Hex())
+		}
+	}
+
+	check(ZERO, ZERO)
+	check(MAX, MAX)                   // The sum would wrap
+	check(MAX, ZERO)                  // Floor and ceiling differ by one
+	check(MAX, MAX.Sub(ONE))          // Adjacent at the top
+	check(ZERO, ONE)                  // Adjacent at the bottom
+	check(ONE.Shl(511), ONE.Shl(511)) // Sum is exactly 2^512
+	check(New(6), New(6))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		a := randUint512(r)
+		check(a, randUint512(r))
+		check(a, a.Add(ONE))
+	}
+}
+
+// TestIncDec tests increment and decrement across word boundaries and wraparound
+func TestIncDec(t *testing.T) {
+	tests := []struct {
+		name string
+		u    *Uint512
+		wrap bool
+	}{
+		{"Zero", ZERO, false},
+		{"Low word full", New(^uint64(0)), false},
+		{"Word boundary", ONE.Shl(64), false},
+		{"All but top word full", MAX.Shr(64), false},
+		{"Top bit", ONE.Shl(511), false},
+		{"MAX", MAX, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := tt.u.Add(ONE)
+			u := tt.u.Clone()
+			if wrapped := u.Inc(); !u.Equal(want) || wrapped != tt.wrap {
+				t.Errorf("Inc(%s) = (%s, %v), want (%s, %v)", tt.u.Hex(), u.Hex(), wrapped, want.Hex(), tt.wrap)
+			}
+			if got := tt.u.Succ(); !got.Equal(want) {
+				t.Errorf("Succ(%s) = %s, want %s", tt.u.Hex(), got.Hex(), want.Hex())
+			}
+
+			// Dec undoes Inc, wrapping back exactly when Inc wrapped
+			if wrapped := u.Dec(); !u.Equal(tt.u) || wrapped != tt.wrap {
+				t.Errorf("Dec(%s) = (%s, %v), want (%s, %v)", want.Hex(), u.Hex(), wrapped, tt.u.Hex(), tt.wrap)
+			}
+			if got := want.Pred(); !got.Equal(tt.u) {
+				t.Errorf("Pred(%s) = %s, want %s", want.Hex(), got.Hex(), tt.u.Hex())
+			}
+		})
+	}
+
+	if !ONE.Equal(New(1)) || !ZERO.IsZero() {
+		t.Error("Succ and Pred modified a global constant")
+	}
+}
+
+// TestSqrt tests the integer square root
+func TestSqrt(t *testing.T) {
+	check := func(u *Uint512) {
+		t.Helper()
+		r := u.Sqrt()
+
+		// Verify r*r <= u < (r+1)*(r+1)
+		n, root := toBig(u), toBig(r)
+		next := new(big.Int).Add(root, big.NewInt(1))
+		if new(big.Int).Mul(root, root).Cmp(n) > 0 || new(big.Int).Mul(next, next).Cmp(n) <= 0 {
+			t.Errorf("Sqrt(%s) = %s, want %s", u.String(), r.String(), new(big.Int).Sqrt(n).String())
+		}
+
+		s, rem := u.SqrtRem()
+		wantRem := new(big.Int).Sub(n, new(big.Int).Mul(root, root))
+		if !s.Equal(r) || toBig(rem).Cmp(wantRem) != 0 {
+			t.Errorf("SqrtRem(%s) = (%s, %s), want (%s, %s)", u.String(), s.String(), rem.String(), r.String(), wantRem.String())
+		}
+	}
+
+	if !ZERO.Sqrt().IsZero() {
+		t.Error("Sqrt(0) should be 0")
+	}
+	if !ONE.Sqrt().Equal(ONE) {
+		t.Error("Sqrt(1) should be 1")
+	}
+
+	for _, root := range []*Uint512{New(2), New(3), New(1000), New(^uint64(0)), ONE.Shl(200), MAX.Shr(256)} {
+		square := fromBig(new(big.Int).Mul(toBig(root), toBig(root)))
+		if !square.Sqrt().Equal(root) {
+			t.Errorf("Sqrt(%s^2) = %s, want %s", root.String(), square.Sqrt().String(), root.String())
+		}
+		check(square)
+		check(square.Sub(ONE))
+		check(square.Add(ONE))
+	}
+
+	check(ZERO)
+	check(ONE)
+
+	// floor(√(2^512 - 1)) = 2^256 - 1, leaving the largest possible remainder 2^257 - 2
+	if !MAX.Sqrt().Equal(MAX.Shr(256)) {
+		t.Errorf("Sqrt(MAX) = %s, want %s", MAX.Sqrt().Hex(), MAX.Shr(256).Hex())
+	}
+	if _, rem := MAX.SqrtRem(); !rem.Equal(ONE.Shl(257).Sub(New(2))) {
+		t.Errorf("SqrtRem(MAX) remainder = %s, want 2^257 - 2", rem.Hex())
+	}
+	check(MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		check(randUint512(r))
+	}
+}
+
+// TestCbrt tests the integer cube root
+func TestCbrt(t *testing.T) {
+	cube := func(b *big.Int) *big.Int {
+		return new(big.Int).Mul(b, new(big.Int).Mul(b, b))
+	}
+	check := func(u *Uint512) {
+		t.Helper()
+		r := u.Cbrt()
+
+		// Verify r^3 <= u < (r+1)^3
+		n, root := toBig(u), toBig(r)
+		next := new(big.Int).Add(root, big.NewInt(1))
+		if cube(root).Cmp(n) > 0 || cube(next).Cmp(n) <= 0 {
+			t.Errorf("Cbrt(%s) = %s", u.String(), r.String())
+		}
+	}
+
+	if !ZERO.Cbrt().IsZero() {
+		t.Error("Cbrt(0) should be 0")
+	}
+
+	for i := uint64(1); i <= 20; i++ {
+		perfect := New(i * i * i)
+		if !perfect.Cbrt().Equal(New(i)) {
+			t.Errorf("Cbrt(%d) = %s, want %d", i*i*i, perfect.Cbrt().String(), i)
+		}
+		check(perfect.Sub(ONE))
+		check(perfect.Add(ONE))
+	}
+
+	for _, root := range []*Uint512{New(^uint64(0)), ONE.Shl(100), ONE.Shl(170).Sub(ONE)} {
+		perfect := fromBig(cube(toBig(root)))
+		if !perfect.Cbrt().Equal(root) {
+			t.Errorf("Cbrt(%s^3) = %s, want %s", root.String(), perfect.Cbrt().String(), root.String())
+		}
+		check(perfect.Sub(ONE))
+		check(perfect.Add(ONE))
+	}
+
+	check(MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		check(randUint512(r))
+	}
+}
+
+// TestMulComposesWithUint1024 tests that the product is a uint1024.Uint1024
+// usable directly with the uint1024 package
+func TestMulComposesWithUint1024(t *testing.T) {
+	a := New(1 << 40)
+	product := a.Mul(a).Add(uint1024.ONE)
+
+	expected := uint1024.ONE.Shl(80).Add(uint1024.ONE)
+	if !product.Equal(expected) {
+		t.Errorf("2^40 * 2^40 + 1 = %s, want %s", product.String(), expected.String())
+	}
+
+	if !MAX.Square().Equal(MAX.Mul(MAX)) {
+		t.Error("Square and Mul should return equal uint1024 values")
+	}
+}
+
+// TestMulFullWidth tests the 1024-bit product against math/big
+func TestMulFullWidth(t *testing.T) {
+	check := func(a, b *Uint512) {
+		t.Helper()
+		want := new(big.Int).Mul(toBig(a), toBig(b))
+		if got := a.Mul(b).String(); got != want.String() {
+			t.Errorf("%s * %s = %s, want %s", a.Hex(), b.Hex(), got, want.String())
+		}
+	}
+
+	check(MAX, MAX)
+	check(MAX, ONE)
+	check(ZERO, MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), randUint512(r))
+	}
+}
+
+// TestAddSmall tests single-word addition against math/big
+func TestAddSmall(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 512)
+	check := func(u *Uint512, val uint64) {
+		t.Helper()
+		want := new(big.Int).Add(toBig(u), new(big.Int).SetUint64(val))
+		want.Mod(want, modulus)
+		got := u.AddSmall(val)
+		if toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s + %d = %s, want %s", u.Hex(), val, got.Hex(), want.Text(16))
+		}
+		inPlace := u.Clone()
+		inPlace.AddSmallInPlace(val)
+		if !inPlace.Equal(got) {
+			t.Errorf("AddSmallInPlace(%d) = %s, want %s", val, inPlace.Hex(), got.Hex())
+		}
+	}
+
+	check(ZERO, 0)
+	check(ZERO, ^uint64(0))
+	check(New(^uint64(0)), 1)               // Carries into the second word
+	check(ONE.Shl(64).Sub(ONE).Shl(448), 1) // No carry despite high bits set
+	check(MAX, 1)                           // Wraps to zero
+	check(MAX, ^uint64(0))                  // Wraps with a full-word carry
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), r.Uint64())
+	}
+}
+
+// TestSubSmall tests single-word subtraction against math/big
+func TestSubSmall(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 512)
+	check := func(u *Uint512, val uint64) {
+		t.Helper()
+		want := new(big.Int).Sub(toBig(u), new(big.Int).SetUint64(val))
+		want.Mod(want, modulus)
+		got := u.SubSmall(val)
+		if toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s - %d = %s, want %s", u.Hex(), val, got.Hex(), want.Text(16))
+		}
+		inPlace := u.Clone()
+		inPlace.SubSmallInPlace(val)
+		if !inPlace.Equal(got) {
+			t.Errorf("SubSmallInPlace(%d) = %s, want %s", val, inPlace.Hex(), got.Hex())
+		}
+	}
+
+	check(ZERO, 0)
+	check(ZERO, 1)          // Wraps to MAX
+	check(ZERO, ^uint64(0)) // Wraps with a full-word borrow
+	check(ONE.Shl(64), 1)   // Borrows from the second word
+	check(ONE.Shl(448), 1)  // Borrows through every lower word
+	check(MAX, ^uint64(0))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), r.Uint64())
+	}
+}
+
+// TestMulSmall tests single-word multiplication against math/big
+func TestMulSmall(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 512)
+	check := func(u *Uint512, val uint64) {
+		t.Helper()
+		want := new(big.Int).Mul(toBig(u), new(big.Int).SetUint64(val))
+		want.Mod(want, modulus)
+		if got := u.MulSmall(val); toBig(got).Cmp(want) != 0 {
+			t.Errorf("%s * %d = %s, want %s", u.Hex(), val, got.Hex(), want.Text(16))
+		}
+	}
+
+	check(New(12345), 0)
+	check(New(12345), 1)
+	check(New(12345), 10)
+	check(MAX, 2)            // Wraps
+	check(MAX, ^uint64(0))   // Wraps with maximal carries
+	check(ONE.Shl(448), 256) // Carries out of the top word
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), r.Uint64())
+	}
+}
+
+// TestMulHigh tests the high half of the product against the full Mul result
+func TestMulHigh(t *testing.T) {
+	check := func(a, b *Uint512) {
+		t.Helper()
+		product := a.Mul(b).Limbs()
+		want := FromLimbs(product[8:])
+		if got := a.MulHigh(b); !got.Equal(want) {
+			t.Errorf("MulHigh(%s, %s) = %s, want %s", a.Hex(), b.Hex(), got.Hex(), want.Hex())
+		}
+	}
+
+	// (2^512 - 1)^2 = 2^1024 - 2^513 + 1, whose high half is MAX - 1
+	if !MAX.MulHigh(MAX).Equal(MAX.Sub(ONE)) {
+		t.Errorf("MulHigh(MAX, MAX) = %s, want MAX - 1", MAX.MulHigh(MAX).Hex())
+	}
+	if !New(12345).MulHigh(New(67890)).IsZero() {
+		t.Error("MulHigh of small operands should be zero")
+	}
+
+	// The high half here is produced purely by carries out of the low half
+	check(MAX, New(2))
+	check(ONE.Shl(511), ONE.Shl(1))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), randUint512(r))
+	}
+}
+
+// TestSquare tests that squaring matches the general multiplication
+func TestSquare(t *testing.T) {
+	check := func(u *Uint512) {
+		t.Helper()
+		want := new(big.Int).Mul(toBig(u), toBig(u))
+		if got := u.Square(); got.String() != want.String() || got.String() != u.Mul(u).String() {
+			t.Errorf("Square(%s) = %s, want %s", u.Hex(), got.String(), want.String())
+		}
+	}
+
+	check(ZERO)
+	check(ONE)
+	check(New(^uint64(0)))
+	check(MAX)
+	check(ONE.Shl(511))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r))
+	}
+}
+
+// TestPow tests wrapping exponentiation against math/big
+func TestPow(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 512)
+
+	tests := []struct {
+		base *Uint512
+		exp  uint
+	}{
+		{ZERO, 0},
+		{ZERO, 5},
+		{ONE, 1000},
+		{New(2), 10},
+		{New(2), 511},
+		{New(2), 512}, // Wraps to zero
+		{New(3), 1000},
+		{MAX, 2},
+		{MAX, 3},
+	}
+
+	for _, tt := range tests {
+		result := tt.base.Pow(tt.exp)
+		want := new(big.Int).Exp(toBig(tt.base), new(big.Int).SetUint64(uint64(tt.exp)), modulus)
+		if toBig(result).Cmp(want) != 0 {
+			t.Errorf("%s^%d = %s, want %s", tt.base.Hex(), tt.exp, result.Hex(), want.Text(16))
+		}
+	}
+
+	if !New(10).Pow(3).Equal(New(1000)) {
+		t.Errorf("10^3: got %s, want 1000", New(10).Pow(3).String())
+	}
+}
+
+// TestCheckedArithmetic tests that checked operations report wrapping with sentinel errors
+func TestCheckedArithmetic(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 512)
+	ops := []struct {
+		name    string
+		checked func(a, b *Uint512) (*Uint512, error)
+		exact   func(z, x, y *big.Int) *big.Int
+	}{
+		{"CheckedAdd", (*Uint512).CheckedAdd, (*big.Int).Add},
+		{"CheckedSub", (*Uint512).CheckedSub, (*big.Int).Sub},
+		{"CheckedMul", (*Uint512).CheckedMul, (*big.Int).Mul},
+	}
+
+	values := []*Uint512{ZERO, ONE, New(2), MAX, MAX.Sub(ONE), ONE.Shl(256), ONE.Shl(255), ONE.Shl(511)}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		values = append(values, randUint512(r))
+	}
+
+	for _, op := range ops {
+		t.Run(op.name, func(t *testing.T) {
+			for _, a := range values {
+				for _, b := range values {
+					want := op.exact(new(big.Int), toBig(a), toBig(b))
+					got, err := op.checked(a, b)
+
+					switch {
+					case want.Sign() < 0:
+						if !errors.Is(err, ErrUnderflow) {
+							t.Errorf("%s(%s, %s): got (%v, %v), want ErrUnderflow", op.name, a, b, got, err)
+						}
+					case want.Cmp(modulus) >= 0:
+						if !errors.Is(err, ErrOverflow) {
+							t.Errorf("%s(%s, %s): got (%v, %v), want ErrOverflow", op.name, a, b, got, err)
+						}
+					case err != nil || toBig(got).Cmp(want) != 0:
+						t.Errorf("%s(%s, %s) = (%v, %v), want %s", op.name, a, b, got, err, want)
+					}
+				}
+			}
+		})
+	}
+
+	if got, err := ZERO.CheckedSub(ONE); got != nil || !errors.Is(err, ErrUnderflow) {
+		t.Errorf("0 - 1 = (%v, %v), want (nil, ErrUnderflow)", got, err)
+	}
+	if got, err := New(10).CheckedSub(New(5)); err != nil || !got.Equal(New(5)) {
+		t.Errorf("10 - 5 = (%v, %v), want (5, nil)", got, err)
+	}
+	if got, err := MAX.CheckedMul(New(2)); got != nil || !errors.Is(err, ErrOverflow) {
+		t.Errorf("MAX * 2 = (%v, %v), want (nil, ErrOverflow)", got, err)
+	}
+	if got, err := New(1000).CheckedMul(New(1000)); err != nil || !got.Equal(New(1000000)) {
+		t.Errorf("1000 * 1000 = (%v, %v), want (1000000, nil)", got, err)
+	}
+}
+
+// TestCheckedPow tests overflow-checked exponentiation against math/big
+func TestCheckedPow(t *testing.T) {
+	limit := new(big.Int).Lsh(big.NewInt(1), 512)
+	check := func(base *Uint512, exp uint64) {
+		t.Helper()
+		want := new(big.Int).Exp(toBig(base), new(big.Int).SetUint64(exp), nil)
+		result, err := base.CheckedPow(exp)
+		if want.Cmp(limit) >= 0 {
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%s^%d: got (%v, %v), want ErrOverflow", base.Hex(), exp, result, err)
+			}
+			return
+		}
+		if err != nil || toBig(result).Cmp(want) != 0 {
+			t.Errorf("%s^%d = (%v, %v), want %s", base.Hex(), exp, result, err, want.Text(16))
+		}
+	}
+
+	// Largest exponent that fits, and the first that does not
+	if result, err := New(2).CheckedPow(511); err != nil || !result.Equal(ONE.Shl(511)) {
+		t.Errorf("2^511 = (%v, %v), want 2^511", result, err)
+	}
+	if _, err := New(2).CheckedPow(512); !errors.Is(err, ErrOverflow) {
+		t.Errorf("2^512: got %v, want ErrOverflow", err)
+	}
+
+	// Fast paths and trivial bases
+	check(ZERO, 0)
+	check(MAX, 0)
+	check(MAX, 1)
+	for _, base := range []*Uint512{ZERO, ONE} {
+		if result, err := base.CheckedPow(^uint64(0)); err != nil || !result.Equal(base) {
+			t.Errorf("%s^(2^64-1) = (%v, %v), want %s", base, result, err, base)
+		}
+	}
+	check(MAX, 2)
+	check(ONE.Shl(255), 2)
+	check(ONE.Shl(256), 2)
+	check(New(3), 323) // 3^323 < 2^512 < 3^324
+	check(New(3), 324)
+	check(New(10), 154) // 10^154 < 2^512 < 10^155
+	check(New(10), 155)
+	if _, err := New(2).CheckedPow(^uint64(0)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("2^(2^64-1): got %v, want ErrOverflow", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(New(r.Uint64()), uint64(r.Intn(12)))
+		check(randUint512(r).Shr(uint(r.Intn(512))), uint64(r.Intn(40)))
+	}
+}
+
+// TestPowMod tests modular exponentiation against math/big
+func TestPowMod(t *testing.T) {
+	check := func(base *Uint512, exp uint, mod *Uint512) {
+		t.Helper()
+		result, err := base.PowMod(exp, mod)
+		if err != nil {
+			t.Fatalf("PowMod failed: %v", err)
+		}
+		want := new(big.Int).Exp(toBig(base), new(big.Int).SetUint64(uint64(exp)), toBig(mod))
+		if toBig(result).Cmp(want) != 0 {
+			t.Errorf("%s^%d mod %s = %s, want %s", base.Hex(), exp, mod.Hex(), result.Hex(), want.Text(16))
+		}
+	}
+
+	check(New(2), 10, New(1000))
+	check(New(5), 0, New(7))
+	check(New(5), 0, ONE) // Everything is zero modulo one
+	check(MAX, 65537, MAX.Sub(ONE))
+	check(MAX, 3, ONE.Shl(511).Add(ONE)) // Modulus with the top bit set
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5; i++ {
+		mod := randUint512(r)
+		if mod.IsZero() {
+			continue
+		}
+		check(randUint512(r), uint(r.Intn(1000)), mod)
+	}
+
+	if _, err := New(2).PowMod(3, ZERO); err == nil {
+		t.Error("PowMod with zero modulus should return error")
+	}
+}
+
+// TestDivMod tests combined division and modulo against math/big
+func TestDivMod(t *testing.T) {
+	check := func(a, b *Uint512) {
+		t.Helper()
+		q, r, err := a.DivMod(b)
+		if err != nil {
+			t.Fatalf("DivMod failed: %v", err)
+		}
+		wantQ, wantR := new(big.Int).QuoRem(toBig(a), toBig(b), new(big.Int))
+		if toBig(q).Cmp(wantQ) != 0 || toBig(r).Cmp(wantR) != 0 {
+			t.Errorf("DivMod(%s, %s) = (%s, %s), want (%s, %s)", a.Hex(), b.Hex(), q.Hex(), r.Hex(), wantQ.Text(16), wantR.Text(16))
+		}
+	}
+
+	check(New(100), New(7))
+	check(New(5), New(7))
+	check(New(7), New(7))
+	check(MAX, ONE)
+	check(MAX, MAX.Sub(ONE))
+
+	// Divisors with the top bit set exercise the carry out of the shifted remainder
+	check(MAX, ONE.Shl(511).Add(ONE))
+	check(MAX.Sub(ONE), MAX.Shr(1).Add(New(2)))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		divisor := randUint512(r)
+		if divisor.IsZero() {
+			continue
+		}
+		check(randUint512(r), divisor)
+	}
+
+	if _, _, err := ONE.DivMod(ZERO); err == nil {
+		t.Error("DivMod by zero should return error")
+	}
+}
+
+// TestDivModReconstruction tests that q*b + r == a and r < b for random operands
+func TestDivModReconstruction(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < 200; i++ {
+		a, b := randUint512(r), randUint512(r)
+		if b.IsZero() {
+			continue
+		}
+
+		q, rem, err := a.DivMod(b)
+		if err != nil {
+			t.Fatalf("DivMod failed: %v", err)
+		}
+		if !rem.Less(b) {
+			t.Errorf("DivMod(%s, %s): remainder %s is not below the divisor", a.Hex(), b.Hex(), rem.Hex())
+		}
+
+		// q*b <= a, so the full product must fit in 512 bits
+		product := q.Mul(b).Limbs()
+		for _, word := range product[8:] {
+			if word != 0 {
+				t.Fatalf("DivMod(%s, %s): q*b overflows 512 bits", a.Hex(), b.Hex())
+			}
+		}
+		if !FromLimbs(product[:8]).Add(rem).Equal(a) {
+			t.Errorf("DivMod(%s, %s): q*b + r != a", a.Hex(), b.Hex())
+		}
+
+		// Div and Mod must agree with DivMod
+		divQ, _ := a.Div(b)
+		modR, _ := a.Mod(b)
+		if !divQ.Equal(q) || !modR.Equal(rem) {
+			t.Errorf("Div/Mod disagree with DivMod for %s, %s", a.Hex(), b.Hex())
+		}
+	}
+
+	// Fast paths
+	if q, rem, _ := New(5).DivMod(New(7)); !q.IsZero() || !rem.Equal(New(5)) {
+		t.Error("5 divmod 7 should be (0, 5)")
+	}
+	if q, rem, _ := New(7).DivMod(New(7)); !q.Equal(ONE) || !rem.IsZero() {
+		t.Error("7 divmod 7 should be (1, 0)")
+	}
+	if q, rem, err := New(7).DivMod(ZERO); err == nil || q != nil || rem != nil {
+		t.Error("DivMod by zero should return nil results and an error")
+	}
+}
+
+// TestStringLarge tests decimal conversion of multi-word values against math/big
+func TestStringLarge(t *testing.T) {
+	tests := []*Uint512{New(999999999), New(1000000000), New(1000000000000000000), MAX}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		tests = append(tests, randUint512(r))
+	}
+
+	for _, u := range tests {
+		if got, want := u.String(), toBig(u).String(); got != want {
+			t.Errorf("String() = %s, want %s", got, want)
+		}
+	}
+}
+
+// TestDivModSmallDivisor tests that the single-word fast path matches binary long division
+func TestDivModSmallDivisor(t *testing.T) {
+	check := func(u *Uint512, divisor uint64) {
+		t.Helper()
+		d := New(divisor)
+		q, r, err := u.DivMod(d)
+		if err != nil {
+			t.Fatalf("%s / %d: unexpected error %v", u, divisor, err)
+		}
+		wantQ, wantR := u.divModBits(d)
+		if !q.Equal(wantQ) || !r.Equal(wantR) {
+			t.Errorf("%s / %d = (%s, %s), want (%s, %s)", u, divisor, q, r, wantQ, wantR)
+		}
+	}
+
+	for _, divisor := range []uint64{1, 2, 3, 10, 86400, 1000000000, 1<<32 - 1, 1 << 32, 1<<32 + 1, 1 << 63, ^uint64(0)} {
+		check(MAX, divisor)
+		check(ONE.Shl(511), divisor)
+		check(New(divisor).Sub(ONE), divisor) // Dividend below the divisor
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), r.Uint64()|1)
+		check(randUint512(r), r.Uint64()>>uint(r.Intn(64))|1)
+	}
+}
+
+// TestDivRounding tests DivCeil and DivRound against math/big
+func TestDivRounding(t *testing.T) {
+	check := func(a, b *Uint512) {
+		t.Helper()
+		x, y := toBig(a), toBig(b)
+		q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+
+		wantCeil := new(big.Int).Set(q)
+		if r.Sign() != 0 {
+			wantCeil.Add(wantCeil, big.NewInt(1))
+		}
+		if got, err := a.DivCeil(b); err != nil || toBig(got).Cmp(wantCeil) != 0 {
+			t.Errorf("DivCeil(%s, %s) = (%s, %v), want %s", a, b, got, err, wantCeil)
+		}
+
+		wantRound := new(big.Int).Set(q)
+		if new(big.Int).Lsh(r, 1).Cmp(y) >= 0 {
+			wantRound.Add(wantRound, big.NewInt(1))
+		}
+		if got, err := a.DivRound(b); err != nil || toBig(got).Cmp(wantRound) != 0 {
+			t.Errorf("DivRound(%s, %s) = (%s, %v), want %s", a, b, got, err, wantRound)
+		}
+	}
+
+	if _, err := ONE.DivCeil(ZERO); err == nil {
+		t.Error("DivCeil by zero: expected error")
+	}
+	if _, err := ONE.DivRound(ZERO); err == nil {
+		t.Error("DivRound by zero: expected error")
+	}
+
+	check(ZERO, ONE)
+	check(New(12), New(4)) // Exact
+	check(New(13), New(4)) // Below half
+	check(New(14), New(4)) // Exactly half rounds up
+	check(New(15), New(4)) // Above half
+	check(New(7), New(2))
+	check(MAX, ONE)
+	check(MAX, New(2))              // MAX is odd: ceiling and rounding both give 2^511
+	check(MAX, MAX)                 // Exact
+	check(MAX.Sub(ONE), MAX)        // Remainder just below the divisor
+	check(MAX.Shr(1), MAX)          // Remainder just below half
+	check(MAX.Shr(1).Add(ONE), MAX) // Remainder just above half
+	check(ONE.Shl(511), MAX)        // The largest remainder whose double overflows
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), randUint512(r).Shr(uint(r.Intn(512))).Or(ONE))
+	}
+}
+
+// TestSmallArithmetic tests the uint64-operand methods and their InPlace variants
+// against the whole-width operations at the wrap boundaries
+func TestSmallArithmetic(t *testing.T) {
+	type op struct {
+		name    string
+		got     func(u *Uint512, v uint64) *Uint512
+		inPlace func(u *Uint512, v uint64)
+		want    func(u *Uint512, v uint64) *Uint512
+	}
+	ops := []op{
+		{"AddSmall", (*Uint512).AddSmall, (*Uint512).AddSmallInPlace,
+			func(u *Uint512, v uint64) *Uint512 { return u.Add(New(v)) }},
+		{"SubSmall", (*Uint512).SubSmall, (*Uint512).SubSmallInPlace,
+			func(u *Uint512, v uint64) *Uint512 { return u.Sub(New(v)) }},
+		{"MulSmall", (*Uint512).MulSmall, (*Uint512).MulSmallInPlace,
+			func(u *Uint512, v uint64) *Uint512 { return FromLimbs(u.Mul(New(v)).ToLimbs()[:8]) }},
+	}
+
+	// Values around the wrap boundaries of every operation
+	values := []*Uint512{ZERO, ONE, MAX, MAX.Sub(ONE), ONE.Shl(511), ONE.Shl(64), New(^uint64(0))}
+	scalars := []uint64{0, 1, 2, ^uint64(0)}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		values = append(values, randUint512(r))
+		scalars = append(scalars, r.Uint64())
+	}
+
+	for _, o := range ops {
+		t.Run(o.name, func(t *testing.T) {
+			for _, u := range values {
+				for _, v := range scalars {
+					want := o.want(u, v)
+					if got := o.got(u, v); !got.Equal(want) {
+						t.Errorf("%s(%s, %d) = %s, want %s", o.name, u.Hex(), v, got.Hex(), want.Hex())
+					}
+
+					inPlace := u.Clone()
+					o.inPlace(inPlace, v)
+					if !inPlace.Equal(want) {
+						t.Errorf("%sInPlace(%s, %d) = %s, want %s", o.name, u.Hex(), v, inPlace.Hex(), want.Hex())
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestDivExact tests exact division against Div, including divisors with large powers of two
+func TestDivExact(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 300; i++ {
+		// b has up to 511 trailing zeros and q*b fits in 512 bits
+		tz := uint(r.Intn(512))
+		b := randUint512(r).Shl(tz)
+		if b.IsZero() {
+			b = ONE.Shl(tz)
+		}
+		q := randUint512(r).Shr(uint(512 - b.LeadingZeros()))
+		a := q.mulLow(b)
+
+		got, err := a.DivExact(b)
+		if err != nil || !got.Equal(q) {
+			t.Errorf("DivExact(%s, %s) = (%v, %v), want %s", a, b, got, err, q)
+		}
+
+		// A non-zero remainder is reported, whether the odd parts or the powers of two disagree
+		if inexact := a.Add(ONE); !b.Equal(ONE) {
+			if _, rem, _ := inexact.DivMod(b); !rem.IsZero() {
+				if got, err := inexact.DivExact(b); err == nil {
+					t.Errorf("DivExact(%s, %s) = %s, expected inexact division error", inexact, b, got)
+				}
+			}
+		}
+	}
+
+	for _, tc := range []struct{ a, b, want *Uint512 }{
+		{ZERO, New(7), ZERO},
+		{MAX, MAX, ONE},
+		{MAX, ONE, MAX},
+		{ONE.Shl(511), ONE.Shl(511), ONE},
+		{ONE.Shl(511), ONE.Shl(200), ONE.Shl(311)},
+		{MAX.Sub(ONE), New(2), MAX.Shr(1)},
+		{New(3 * 1000000007).Shl(300), New(1000000007).Shl(100), New(3).Shl(200)},
+	} {
+		if got, err := tc.a.DivExact(tc.b); err != nil || !got.Equal(tc.want) {
+			t.Errorf("DivExact(%s, %s) = (%v, %v), want %s", tc.a, tc.b, got, err, tc.want)
+		}
+	}
+
+	for _, tc := range []struct{ a, b *Uint512 }{
+		{New(10), ZERO},
+		{New(10), New(3)},
+		{New(10), New(4)},
+		{ONE.Shl(300), ONE.Shl(301)},
+		{MAX, MAX.Sub(ONE)},
+	} {
+		if got, err := tc.a.DivExact(tc.b); err == nil {
+			t.Errorf("DivExact(%s, %s) = %s, expected error", tc.a, tc.b, got)
+		}
+	}
+}
+
+// TestDivSmall tests single-word division against math/big
+func TestDivSmall(t *testing.T) {
+	check := func(u *Uint512, divisor uint64) {
+		t.Helper()
+		before := u.Clone()
+		q, rem := u.DivSmall(divisor)
+		if !u.Equal(before) {
+			t.Fatalf("DivSmall modified the receiver: %s, was %s", u, before)
+		}
+
+		wantQ, wantR := new(big.Int).QuoRem(toBig(u), new(big.Int).SetUint64(divisor), new(big.Int))
+		if toBig(q).Cmp(wantQ) != 0 || rem != wantR.Uint64() {
+			t.Errorf("%s / %d = (%s, %d), want (%s, %s)", u, divisor, q, rem, wantQ, wantR)
+		}
+	}
+
+	for _, divisor := range []uint64{1, 3, 10, 1000000000, 1 << 32, 1<<32 + 1, ^uint64(0)} {
+		check(ZERO, divisor)
+		check(MAX, divisor)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), r.Uint64()>>uint(r.Intn(64))|1)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("DivSmall(0): expected panic")
+		}
+	}()
+	MAX.DivSmall(0)
+}
+
+// TestModSmall tests single-word modulo against math/big
+func TestModSmall(t *testing.T) {
+	check := func(u *Uint512, divisor uint64) {
+		t.Helper()
+		want := new(big.Int).Mod(toBig(u), new(big.Int).SetUint64(divisor))
+		if got := u.ModSmall(divisor); got != want.Uint64() {
+			t.Errorf("%s %% %d = %d, want %s", u, divisor, got, want)
+		}
+	}
+
+	for _, divisor := range []uint64{1, 2, 7, 10, 1000000007, 1 << 32, ^uint64(0)} {
+		check(ZERO, divisor)
+		check(MAX, divisor)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), r.Uint64()>>uint(r.Intn(64))|1)
+	}
+
+	if allocs := testing.AllocsPerRun(10, func() { MAX.ModSmall(10) }); allocs != 0 {
+		t.Errorf("ModSmall allocated %v times, want 0", allocs)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("ModSmall(0): expected panic")
+		}
+	}()
+	MAX.ModSmall(0)
+}
+
+// TestOverflowReporting tests the overflow flags against math/big
+func TestOverflowReporting(t *testing.T) {
+	modulus := new(big.Int).Lsh(big.NewInt(1), 512)
+	check := func(a, b *Uint512) {
+		t.Helper()
+		x, y := toBig(a), toBig(b)
+
+		sum := new(big.Int).Add(x, y)
+		got, overflow := a.AddOverflow(b)
+		if wantOverflow := sum.Cmp(modulus) >= 0; overflow != wantOverflow || toBig(got).Cmp(sum.Mod(sum, modulus)) != 0 {
+			t.Errorf("AddOverflow(%s, %s) = (%s, %v), want (%s, %v)", a.Hex(), b.Hex(), got.Hex(), overflow, sum.Text(16), wantOverflow)
+		}
+		var wantCarry uint64
+		if overflow {
+			wantCarry = 1
+		}
+		if sumWithCarry, carry := a.AddWithCarry(b); !sumWithCarry.Equal(got) || carry != wantCarry {
+			t.Errorf("AddWithCarry(%s, %s) = (%s, %d), want (%s, %v)", a.Hex(), b.Hex(), sumWithCarry.Hex(), carry, got.Hex(), overflow)
+		}
+
+		diff := new(big.Int).Sub(x, y)
+		got, underflow := a.SubUnderflow(b)
+		if wantUnderflow := diff.Sign() < 0; underflow != wantUnderflow || toBig(got).Cmp(diff.Mod(diff, modulus)) != 0 {
+			t.Errorf("SubUnderflow(%s, %s) = (%s, %v), want (%s, %v)", a.Hex(), b.Hex(), got.Hex(), underflow, diff.Text(16), wantUnderflow)
+		}
+		var wantBorrow uint64
+		if underflow {
+			wantBorrow = 1
+		}
+		if diffWithBorrow, borrow := a.SubWithBorrow(b); !diffWithBorrow.Equal(got) || borrow != wantBorrow {
+			t.Errorf("SubWithBorrow(%s, %s) = (%s, %d), want (%s, %v)", a.Hex(), b.Hex(), diffWithBorrow.Hex(), borrow, got.Hex(), underflow)
+		}
+
+		product := new(big.Int).Mul(x, y)
+		got, overflow = a.MulOverflow(b)
+		if wantOverflow := product.Cmp(modulus) >= 0; overflow != wantOverflow || toBig(got).Cmp(product.Mod(product, modulus)) != 0 {
+			t.Errorf("MulOverflow(%s, %s) = (%s, %v), want (%s, %v)", a.Hex(), b.Hex(), got.Hex(), overflow, product.Text(16), wantOverflow)
+		}
+	}
+
+	check(ZERO, ZERO)
+	check(MAX, ZERO)
+	check(MAX, ONE)                   // Sum carries out exactly
+	check(ZERO, ONE)                  // Difference borrows exactly
+	check(MAX, MAX)                   // Everything overflows
+	check(ONE.Shl(256), ONE.Shl(255)) // Product just fits
+	check(ONE.Shl(256), ONE.Shl(256)) // Product is exactly 2^512
+	check(ONE.Shl(511), New(2))
+	check(ONE.Shl(256).Add(ONE), ONE.Shl(256).Sub(ONE)) // 2^512 - 1 fits exactly
+	// No partial product reaches word 8, but summing the two halves of word 7
+	// carries into it
+	check(New(^uint64(0)), FromLimbs([]uint64{0, 0, 0, 0, 0, 0, ^uint64(0), 1}))
+	check(MAX.Shr(1), New(2))
+	check(MAX.Shr(1).Add(ONE), New(2))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), randUint512(r))
+	}
+
+	// Bit lengths summing to 512..514, where only the exact product decides overflow
+	for i := 0; i < 100; i++ {
+		s := uint(r.Intn(512))
+		a := randUint512(r).Shr(s).Or(ONE.Shl(511 - s))
+		b := randUint512(r).Shr(uint(512-int(s)-r.Intn(3)) % 512).Or(ONE)
+		check(a, b)
+	}
+}
+
+// TestAddWithCarryChain adds pairs of Uint512 limbs into 1024-bit sums and
+// compares them with uint1024 addition
+func TestAddWithCarryChain(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		aLo, aHi, bLo, bHi := randUint512(r), randUint512(r), randUint512(r), randUint512(r)
+		if i%4 == 0 {
+			aLo, bLo = MAX, ONE // The low halves always carry
+		}
+
+		lo, carry := aLo.AddWithCarry(bLo)
+		hi := aHi.Add(bHi).AddSmall(carry)
+
+		a := uint1024.FromLimbs(append(aLo.ToLimbs(), aHi.ToLimbs()...))
+		b := uint1024.FromLimbs(append(bLo.ToLimbs(), bHi.ToLimbs()...))
+		want := a.Add(b)
+		if got := uint1024.FromLimbs(append(lo.ToLimbs(), hi.ToLimbs()...)); !got.Equal(want) {
+			t.Errorf("chained %s + %s = %s, want %s", a, b, got, want)
+		}
+	}
+}
+
+// TestSubWithBorrowChain subtracts 1024-bit values stored as pairs of Uint512
+// limbs and compares them with uint1024 subtraction
+func TestSubWithBorrowChain(t *testing.T) {
+	if diff, borrow := ZERO.SubWithBorrow(ONE); !diff.Equal(MAX) || borrow != 1 {
+		t.Errorf("0 - 1 = (%s, %d), want (MAX, 1)", diff, borrow)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		aLo, aHi, bLo, bHi := randUint512(r), randUint512(r), randUint512(r), randUint512(r)
+		if i%4 == 0 {
+			aLo, bLo = ZERO, ONE // The low halves always borrow
+		}
+
+		lo, borrow := aLo.SubWithBorrow(bLo)
+		hi := aHi.Sub(bHi).SubSmall(borrow)
+
+		a := uint1024.FromLimbs(append(aLo.ToLimbs(), aHi.ToLimbs()...))
+		b := uint1024.FromLimbs(append(bLo.ToLimbs(), bHi.ToLimbs()...))
+		want := a.Sub(b)
+		if got := uint1024.FromLimbs(append(lo.ToLimbs(), hi.ToLimbs()...)); !got.Equal(want) {
+			t.Errorf("chained %s - %s = %s, want %s", a, b, got, want)
+		}
+	}
+}
+
+// TestSaturatingArithmetic tests clamping at the bounds, including the exact boundaries
+func TestSaturatingArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  *Uint512
+		want *Uint512
+	}{
+		{"MAX + 0", MAX.SaturatingAdd(ZERO), MAX},
+		{"MAX + 1", MAX.SaturatingAdd(ONE), MAX},
+		{"MAX-1 + 1", MAX.Sub(ONE).SaturatingAdd(ONE), MAX},
+		{"MAX + MAX", MAX.SaturatingAdd(MAX), MAX},
+		{"2 + 3", New(2).SaturatingAdd(New(3)), New(5)},
+		{"0 - 1", ZERO.SaturatingSub(ONE), ZERO},
+		{"1 - 1", ONE.SaturatingSub(ONE), ZERO},
+		{"1 - MAX", ONE.SaturatingSub(MAX), ZERO},
+		{"MAX - 1", MAX.SaturatingSub(ONE), MAX.Sub(ONE)},
+		{"MAX * 0", MAX.SaturatingMul(ZERO), ZERO},
+		{"MAX * 1", MAX.SaturatingMul(ONE), MAX},
+		{"MAX * 2", MAX.SaturatingMul(New(2)), MAX},
+		{"2^256 * 2^255", ONE.Shl(256).SaturatingMul(ONE.Shl(255)), ONE.Shl(511)},
+		{"2^256 * 2^256", ONE.Shl(256).SaturatingMul(ONE.Shl(256)), MAX},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("got %s, want %s", tt.got.Hex(), tt.want.Hex())
+			}
+		})
+	}
+
+	// Random products, including ones just above and below 2^512, clamp exactly
+	// when the true product exceeds MAX
+	limit := toBig(MAX)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		shift := uint(r.Intn(512))
+		a, b := randUint512(r).Shr(shift), randUint512(r).Shr(511-shift)
+		want := new(big.Int).Mul(toBig(a), toBig(b))
+		if want.Cmp(limit) > 0 {
+			want = limit
+		}
+		if got := a.SaturatingMul(b); toBig(got).Cmp(want) != 0 {
+			t.Errorf("SaturatingMul(%s, %s) = %s, want %s", a, b, got, want)
+		}
+	}
+
+	// The clamped results must not alias the global constants
+	if result := MAX.SaturatingAdd(ONE); result == MAX {
+		t.Error("SaturatingAdd returned the MAX constant itself")
+	}
+	if result := MAX.SaturatingMul(MAX); result == MAX {
+		t.Error("SaturatingMul returned the MAX constant itself")
+	}
+}
+
+// TestAddMod tests modular addition of reduced operands against math/big
+func TestAddMod(t *testing.T) {
+	check := func(a, b, mod *Uint512) {
+		t.Helper()
+		want := new(big.Int).Add(toBig(a), toBig(b))
+		want.Mod(want, toBig(mod))
+		if got := a.AddMod(b, mod); toBig(got).Cmp(want) != 0 {
+			t.Errorf("(%s + %s) %% %s = %s, want %s", a, b, mod, got, want)
+		}
+	}
+
+	check(ZERO, ZERO, ONE)
+	check(New(5), New(6), New(7))
+	check(New(3), New(3), New(7))
+	check(MAX.Sub(ONE), MAX.Sub(ONE), MAX) // The sum carries out of the top word
+	check(MAX.Sub(ONE), ONE, MAX)          // The sum equals mod exactly
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		mod := randUint512(r)
+		if mod.IsZero() {
+			continue
+		}
+		a, _ := randUint512(r).Mod(mod)
+		b, _ := randUint512(r).Mod(mod)
+		check(a, b, mod)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("AddMod with zero modulus: expected panic")
+		}
+	}()
+	ONE.AddMod(ONE, ZERO)
+}
+
+// TestSubMod tests modular subtraction of reduced operands against math/big
+func TestSubMod(t *testing.T) {
+	check := func(a, b, mod *Uint512) {
+		t.Helper()
+		want := new(big.Int).Sub(toBig(a), toBig(b))
+		want.Mod(want, toBig(mod))
+		if got := a.SubMod(b, mod); toBig(got).Cmp(want) != 0 {
+			t.Errorf("(%s - %s) %% %s = %s, want %s", a, b, mod, got, want)
+		}
+	}
+
+	check(ZERO, ZERO, ONE)
+	check(New(6), New(5), New(7))
+	check(New(5), New(6), New(7)) // Wraps below zero
+	check(ZERO, MAX.Sub(ONE), MAX)
+	check(MAX.Sub(ONE), ZERO, MAX)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		mod := randUint512(r)
+		if mod.IsZero() {
+			continue
+		}
+		a, _ := randUint512(r).Mod(mod)
+		b, _ := randUint512(r).Mod(mod)
+		check(a, b, mod)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SubMod with zero modulus: expected panic")
+		}
+	}()
+	ONE.SubMod(ONE, ZERO)
+}
+
+// TestModAddSubMul tests modular arithmetic on unreduced operands against math/big
+func TestModAddSubMul(t *testing.T) {
+	ops := []struct {
+		name  string
+		op    func(a, b, mod *Uint512) (*Uint512, error)
+		exact func(z, x, y *big.Int) *big.Int
+	}{
+		{"ModAdd", (*Uint512).ModAdd, (*big.Int).Add},
+		{"ModSub", (*Uint512).ModSub, (*big.Int).Sub},
+		{"ModMul", (*Uint512).ModMul, (*big.Int).Mul},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, op := range ops {
+		check := func(a, b, mod *Uint512) {
+			t.Helper()
+			want := op.exact(new(big.Int), toBig(a), toBig(b))
+			want.Mod(want, toBig(mod))
+			got, err := op.op(a, b, mod)
+			if err != nil || toBig(got).Cmp(want) != 0 {
+				t.Errorf("%s(%s, %s, %s) = (%v, %v), want %s", op.name, a, b, mod, got, err, want)
+			}
+		}
+
+		// Operands far above the modulus, and sums and products that wrap past 2^512
+		check(MAX, MAX, New(7))
+		check(MAX, MAX, MAX.Sub(ONE))
+		check(ZERO, MAX, New(1000000007))
+		check(New(5), New(6), New(7))
+		check(MAX, ONE, ONE)
+
+		for i := 0; i < 200; i++ {
+			mod := randUint512(r).Shr(uint(r.Intn(512)))
+			if mod.IsZero() {
+				continue
+			}
+			check(randUint512(r), randUint512(r), mod)
+		}
+
+		if _, err := op.op(ONE, ONE, ZERO); err == nil {
+			t.Errorf("%s with zero modulus: expected error", op.name)
+		}
+	}
+}
+
+// TestMulMod tests modular multiplication against math/big
+func TestMulMod(t *testing.T) {
+	check := func(a, b, mod *Uint512) {
+		t.Helper()
+		want := new(big.Int).Mul(toBig(a), toBig(b))
+		want.Mod(want, toBig(mod))
+		if got := a.MulMod(b, mod); toBig(got).Cmp(want) != 0 {
+			t.Errorf("(%s * %s) %% %s = %s, want %s", a, b, mod, got, want)
+		}
+	}
+
+	check(MAX, MAX, ONE)
+	check(MAX, MAX, MAX)
+	check(MAX, MAX, MAX.Sub(ONE))
+	check(MAX, MAX, New(1000000007))
+	check(ONE.Shl(511), New(2), ONE.Shl(511).Add(ONE))
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		mod := randUint512(r)
+		if mod.IsZero() {
+			continue
+		}
+		check(randUint512(r), randUint512(r), mod)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MulMod with zero modulus: expected panic")
+		}
+	}()
+	ONE.MulMod(ONE, ZERO)
+}
+
+// TestGCDLCM tests GCD and LCM against math/big
+func TestGCDLCM(t *testing.T) {
+	limit := new(big.Int).Lsh(big.NewInt(1), 512)
+	check := func(a, b *Uint512) {
+		t.Helper()
+		wantGCD := new(big.Int).GCD(nil, nil, toBig(a), toBig(b))
+		if got := a.GCD(b); toBig(got).Cmp(wantGCD) != 0 {
+			t.Errorf("GCD(%s, %s) = %s, want %s", a, b, got, wantGCD)
+		}
+
+		wantLCM := new(big.Int)
+		if wantGCD.Sign() != 0 {
+			wantLCM.Mul(toBig(a), toBig(b))
+			wantLCM.Quo(wantLCM, wantGCD)
+		}
+		got, err := a.LCM(b)
+		if wantLCM.Cmp(limit) >= 0 {
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("LCM(%s, %s): got (%v, %v), want ErrOverflow", a, b, got, err)
+			}
+		} else if err != nil || toBig(got).Cmp(wantLCM) != 0 {
+			t.Errorf("LCM(%s, %s) = (%v, %v), want %s", a, b, got, err, wantLCM)
+		}
+	}
+
+	check(ZERO, ZERO)
+	check(ZERO, New(12))
+	check(New(12), ZERO)
+	check(New(12), New(18))
+	check(MAX, MAX)
+	check(MAX, ONE.Shl(511))
+	check(ONE.Shl(300), ONE.Shl(200))
+	check(MAX.Sub(ONE), ONE.Shl(256)) // LCM overflows
+	if got := ZERO.GCD(New(12)); !got.Equal(New(12)) {
+		t.Errorf("GCD(0, 12) = %s, want 12", got)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a, b := randUint512(r), randUint512(r)
+		check(a, b)
+
+		// Values sharing a large common factor, so the LCM usually fits
+		c := randUint512(r).Shr(uint(r.Intn(512)))
+		shift := uint(512 - c.LeadingZeros())
+		check(c.mulLow(a.Shr(shift+uint(r.Intn(8)))), c.mulLow(b.Shr(shift)))
+	}
+}
+
+// TestModInverse tests the inverse behind Modulus.Inv against math/big for odd and even moduli
+func TestModInverse(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		mod := randUint512(r).Shr(uint(r.Intn(512)))
+		if i%2 == 0 {
+			mod.words[0] &^= 1 // Even modulus
+		}
+		a := randUint512(r)
+
+		want := new(big.Int).ModInverse(toBig(a), toBig(mod))
+		got, err := a.modInverse(mod)
+		if want == nil || mod.LessOrEqual(ONE) {
+			if !errors.Is(err, ErrNotInvertible) {
+				t.Errorf("modInverse(%s, %s): got (%v, %v), want ErrNotInvertible", a, mod, got, err)
+			}
+			continue
+		}
+		if err != nil || toBig(got).Cmp(want) != 0 {
+			t.Errorf("modInverse(%s, %s) = (%v, %v), want %s", a, mod, got, err, want)
+		}
+		if product := a.MulMod(got, mod); !product.Equal(ONE) {
+			t.Errorf("%s * %s mod %s = %s, want 1", a, got, mod, product)
+		}
+	}
+
+	for _, tc := range []struct{ a, mod *Uint512 }{
+		{New(3), ZERO},
+		{New(3), ONE},
+		{ZERO, New(7)},
+		{New(6), New(9)},
+		{MAX.Sub(ONE), ONE.Shl(511)},
+	} {
+		if _, err := tc.a.modInverse(tc.mod); !errors.Is(err, ErrNotInvertible) {
+			t.Errorf("modInverse(%s, %s): got %v, want ErrNotInvertible", tc.a, tc.mod, err)
+		}
+	}
+
+	if got, err := MAX.modInverse(ONE.Shl(511)); err != nil || !MAX.MulMod(got, ONE.Shl(511)).Equal(ONE) {
+		t.Errorf("modInverse(MAX, 2^511) = (%v, %v)", got, err)
+	}
+}
+
+// TestMulAdd tests fused multiply-add and accumulation against math/big
+func TestMulAdd(t *testing.T) {
+	join := func(hi, lo *Uint512) *big.Int {
+		return new(big.Int).Add(new(big.Int).Lsh(toBig(hi), 512), toBig(lo))
+	}
+	modulus := new(big.Int).Lsh(big.NewInt(1), 1024)
+
+	check := func(a, b, c *Uint512) {
+		t.Helper()
+		want := new(big.Int).Mul(toBig(a), toBig(b))
+		want.Add(want, toBig(c))
+		if hi, lo := a.MulAdd(b, c); join(hi, lo).Cmp(want) != 0 {
+			t.Errorf("MulAdd(%s, %s, %s) = %s, want %s", a.Hex(), b.Hex(), c.Hex(), join(hi, lo).Text(16), want.Text(16))
+		}
+
+		// Accumulate a*b onto hi:lo = c:c, which can carry out of bit 1023
+		hi, lo := c.Clone(), c.Clone()
+		start := join(hi, lo)
+		carry := a.MulAddAcc(b, hi, lo)
+		sum := new(big.Int).Add(start, new(big.Int).Mul(toBig(a), toBig(b)))
+		var wantCarry uint64
+		if sum.Cmp(modulus) >= 0 {
+			wantCarry = 1
+		}
+		if sum.Mod(sum, modulus); join(hi, lo).Cmp(sum) != 0 || carry != wantCarry {
+			t.Errorf("MulAddAcc(%s, %s) onto %s = (%s, carry %d), want (%s, carry %d)",
+				a.Hex(), b.Hex(), start.Text(16), join(hi, lo).Text(16), carry, sum.Text(16), wantCarry)
+		}
+	}
+
+	check(ZERO, ZERO, ZERO)
+	check(MAX, MAX, MAX) // Largest possible result, 2^1024 - 2^512
+	check(MAX, ONE, ONE) // The addend carries into the high half
+	check(MAX, MAX, ZERO)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		check(randUint512(r), randUint512(r), randUint512(r))
+	}
+}
+
+// TestDestinationMethods tests the three-operand methods, including every aliasing pattern
+func TestDestinationMethods(t *testing.T) {
+	ops := []struct {
+		name string
+		to   func(z, x, y *Uint512) *Uint512
+		want func(x, y *Uint512) *Uint512
+	}{
+		{"AddTo", (*Uint512).AddTo, (*Uint512).Add},
+		{"SubTo", (*Uint512).SubTo, (*Uint512).Sub},
+		{"AndTo", (*Uint512).AndTo, (*Uint512).And},
+		{"OrTo", (*Uint512).OrTo, (*Uint512).Or},
+		{"XorTo", (*Uint512).XorTo, (*Uint512).Xor},
+		{"ShlTo", func(z, x, _ *Uint512) *Uint512 { return z.ShlTo(x, 77) },
+			func(x, _ *Uint512) *Uint512 { return x.Shl(77) }},
+		{"ShrTo", func(z, x, _ *Uint512) *Uint512 { return z.ShrTo(x, 77) },
+			func(x, _ *Uint512) *Uint512 { return x.Shr(77) }},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, op := range ops {
+		t.Run(op.name, func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				x, y := randUint512(r), randUint512(r)
+
+				// Separate destination
+				z := randUint512(r)
+				if got := op.to(z, x, y); got != z || !z.Equal(op.want(x, y)) {
+					t.Errorf("z = %s, want %s", z, op.want(x, y))
+				}
+
+				// z == x
+				zx := x.Clone()
+				if op.to(zx, zx, y); !zx.Equal(op.want(x, y)) {
+					t.Errorf("z == x: z = %s, want %s", zx, op.want(x, y))
+				}
+
+				// z == y
+				zy := y.Clone()
+				if op.to(zy, x, zy); !zy.Equal(op.want(x, y)) {
+					t.Errorf("z == y: z = %s, want %s", zy, op.want(x, y))
+				}
+
+				// x == y, and all three the same
+				if op.to(z, x, x); !z.Equal(op.want(x, x)) {
+					t.Errorf("x == y: z = %s, want %s", z, op.want(x, x))
+				}
+				all := x.Clone()
+				if op.to(all, all, all); !all.Equal(op.want(x, x)) {
+					t.Errorf("z == x == y: z = %s, want %s", all, op.want(x, x))
+				}
+			}
+		})
+	}
+
+	acc, step := New(1), New(3)
+	allocs := testing.AllocsPerRun(100, func() {
+		acc.AddTo(acc, step).XorTo(acc, step).ShlTo(acc, 1)
+	})
+	if allocs != 0 {
+		t.Errorf("destination methods allocated %v times per run, want 0", allocs)
+	}
+}
+
+// toBig converts a Uint512 to a big.Int for cross-checking results
+func toBig(u *Uint512) *big.Int {
+	return new(big.Int).SetBytes(u.ToBeBytes())
+}
+
+// fromBig converts a big.Int below 2^512 to a Uint512
+func fromBig(b *big.Int) *Uint512 {
+	return FromBeBytes(b.Bytes())
+}
+
+// BenchmarkSquare compares squaring against the general multiplication
+func BenchmarkSquare(b *testing.B) {
+	u := MAX.Sub(New(12345))
+
+	b.Run("Square", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.Square()
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.Mul(u)
+		}
+	})
+}
+
+// BenchmarkMulSmall compares single-word multiplication against the general Mul
+func BenchmarkMulSmall(b *testing.B) {
+	u := MAX.Sub(New(12345))
+
+	b.Run("MulSmall", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.MulSmall(1000000007)
+		}
+	})
+	b.Run("Mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.Mul(New(1000000007))
+		}
+	})
+}
+
+// BenchmarkDivModSmallDivisor compares the single-word fast path against binary long division
+func BenchmarkDivModSmallDivisor(b *testing.B) {
+	u := MAX.Sub(New(12345))
+	d := New(1000000000)
+
+	b.Run("DivMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.DivMod(d)
+		}
+	})
+	b.Run("BinaryLongDivision", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			u.divModBits(d)
+		}
+	})
+}
+
+// BenchmarkMulMod compares modular multiplication against math/big
+func BenchmarkMulMod(b *testing.B) {
+	x, y := MAX.Sub(New(12345)), MAX.Sub(New(67890))
+	mod := MAX.Shr(1).Sub(New(1000))
+
+	b.Run("MulMod", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			x.MulMod(y, mod)
+		}
+	})
+	b.Run("BigInt", func(b *testing.B) {
+		bx, by, bmod := toBig(x), toBig(y), toBig(mod)
+		z := new(big.Int)
+		for i := 0; i < b.N; i++ {
+			z.Mul(bx, by)
+			z.Mod(z, bmod)
+		}
+	})
+}
+
+// BenchmarkAccumulate compares accumulating with Add against the allocation-free AddTo
+func BenchmarkAccumulate(b *testing.B) {
+	step := MAX.Shr(3)
+
+	b.Run("Add", func(b *testing.B) {
+		b.ReportAllocs()
+		acc := ZERO.Clone()
+		for i := 0; i < b.N; i++ {
+			acc = acc.Add(step)
+		}
+	})
+	b.Run("AddTo", func(b *testing.B) {
+		b.ReportAllocs()
+		acc := ZERO.Clone()
+		for i := 0; i < b.N; i++ {
+			acc.AddTo(acc, step)
+		}
+	})
+}
+
+// BenchmarkDivExact compares exact division by a multi-word divisor with Div
+func BenchmarkDivExact(b *testing.B) {
+	divisor := MAX.Shr(256).Shl(3)
+	a := divisor.mulLow(MAX.Shr(260))
+
+	b.Run("DivExact", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a.DivExact(divisor)
+		}
+	})
+	b.Run("Div", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			a.Div(divisor)
+		}
+	})
+}
+
+// BenchmarkInc compares the in-place increment with adding the ONE constant
+func BenchmarkInc(b *testing.B) {
+	b.Run("Inc", func(b *testing.B) {
+		u := ZERO.Clone()
+		for i := 0; i < b.N; i++ {
+			u.Inc()
+		}
+	})
+	b.Run("AddInPlace", func(b *testing.B) {
+		u := ZERO.Clone()
+		for i := 0; i < b.N; i++ {
+			u.AddInPlace(ONE)
+		}
+	})
+}
+
+// BenchmarkString measures decimal conversion of a full-width value
+func BenchmarkString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = MAX.String()
+	}
+}
+
+// randUint512 returns a random value with a random number of significant limbs,
+// so that tests cover small operands as well as full-width ones
+func randUint512(r *rand.Rand) *Uint512 {
+	u := &Uint512{}
+	n := r.Intn(len(u.words)) + 1
+	for i := 0; i < n; i++ {
+		u.words[i] = r.Uint64()
+	}
+	return u
+}